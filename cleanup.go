@@ -0,0 +1,105 @@
+package colorbear
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+)
+
+// restorable is implemented by components that change terminal state while
+// they run (hidden cursor, partially drawn lines) and can undo it.
+type restorable interface {
+	restore()
+}
+
+var (
+	// active holds all running components that need cleanup on exit.
+	active = map[restorable]struct{}{}
+
+	// activeMu protects active; spinners register from their own goroutines.
+	activeMu sync.Mutex
+)
+
+// register adds a running component to the cleanup registry.
+func register(r restorable) {
+	activeMu.Lock()
+	defer activeMu.Unlock()
+	active[r] = struct{}{}
+}
+
+// unregister removes a component once it has stopped on its own.
+func unregister(r restorable) {
+	activeMu.Lock()
+	defer activeMu.Unlock()
+	delete(active, r)
+}
+
+// Restore stops all running spinners, clears their partial lines and makes
+// the cursor visible again.
+//
+// Spinners hide the cursor while animating. If the program panics or exits
+// while a spinner is running, the terminal would be left without a cursor.
+// Defer Restore() in main to guarantee a clean terminal:
+//
+//	func main() {
+//	    defer colorbear.Restore()
+//	    ...
+//	}
+//
+// Restore is safe to call at any time, also when nothing is running.
+func Restore() {
+	activeMu.Lock()
+	running := make([]restorable, 0, len(active))
+	for r := range active {
+		running = append(running, r)
+	}
+	activeMu.Unlock()
+
+	for _, r := range running {
+		r.restore()
+	}
+
+	if isTerminal() {
+		fmt.Fprint(os.Stdout, showCursor)
+	}
+}
+
+// HandleSignals restores the terminal when the program is interrupted.
+//
+// It installs a handler for SIGINT (Ctrl+C) and SIGTERM that calls Restore()
+// and then exits with the conventional status 128+signal (130 for Ctrl+C).
+// The returned function removes the handler again.
+//
+// Example:
+//
+//	func main() {
+//	    stop := colorbear.HandleSignals()
+//	    defer stop()
+//	    defer colorbear.Restore()
+//	    ...
+//	}
+func HandleSignals() (stop func()) {
+	signals := make(chan os.Signal, 1)
+	quit := make(chan struct{})
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		select {
+		case sig := <-signals:
+			signal.Stop(signals)
+			Restore()
+			os.Exit(exitCode(sig))
+		case <-quit:
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			signal.Stop(signals)
+			close(quit)
+		})
+	}
+}
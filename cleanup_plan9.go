@@ -0,0 +1,12 @@
+//go:build plan9
+
+package colorbear
+
+import "os"
+
+// exitCode returns the exit status for a process killed by sig. Plan 9
+// delivers notes instead of numbered signals, so there is no shell
+// convention to follow.
+func exitCode(sig os.Signal) int {
+	return 1
+}
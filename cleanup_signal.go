@@ -0,0 +1,16 @@
+//go:build !plan9
+
+package colorbear
+
+import (
+	"os"
+	"syscall"
+)
+
+// exitCode returns the shell exit status for a process killed by sig.
+func exitCode(sig os.Signal) int {
+	if s, ok := sig.(syscall.Signal); ok {
+		return 128 + int(s)
+	}
+	return 1
+}
@@ -0,0 +1,47 @@
+package colorbear
+
+import (
+	"os"
+	"syscall"
+	"testing"
+)
+
+func TestRestoreStopsSpinners(t *testing.T) {
+	ForceColors(false)
+
+	spinner1 := NewSpinner("One")
+	spinner2 := NewSpinner("Two")
+	spinner1.Start()
+	spinner2.Start()
+
+	Restore()
+
+	if spinner1.running || spinner2.running {
+		t.Error("Restore() should stop all running spinners")
+	}
+
+	activeMu.Lock()
+	remaining := len(active)
+	activeMu.Unlock()
+	if remaining != 0 {
+		t.Errorf("Expected empty cleanup registry, got %d entries", remaining)
+	}
+
+	// Calling Restore() without running spinners is safe
+	Restore()
+}
+
+func TestHandleSignalsStop(t *testing.T) {
+	stop := HandleSignals()
+	stop()
+	stop() // Calling stop twice is safe
+}
+
+func TestExitCode(t *testing.T) {
+	if code := exitCode(os.Interrupt); code != 130 {
+		t.Errorf("Expected 130 for SIGINT, got %d", code)
+	}
+	if code := exitCode(syscall.SIGTERM); code != 143 {
+		t.Errorf("Expected 143 for SIGTERM, got %d", code)
+	}
+}
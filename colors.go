@@ -1,5 +1,11 @@
 package colorbear
 
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
 // ANSI Color Codes
 //
 // These constants define the ANSI escape sequences used for terminal colors.
@@ -54,7 +60,15 @@ const (
 // It automatically respects the color detection settings and returns
 // plain text if colors are disabled.
 func colorize(text string, codes ...string) string {
-	if !isColorEnabled() {
+	return colorizeIf(isColorEnabled(), text, codes...)
+}
+
+// colorizeIf applies ANSI color codes to text only if enabled is true.
+//
+// Use this for output that goes to a writer other than stdout, together
+// with isColorEnabledFor.
+func colorizeIf(enabled bool, text string, codes ...string) string {
+	if !enabled {
 		return text
 	}
 
@@ -65,3 +79,34 @@ func colorize(text string, codes ...string) string {
 	result += text + Reset
 	return result
 }
+
+// rgb is a 24-bit color used for gradients.
+type rgb struct {
+	r, g, b uint8
+}
+
+// parseHexColor parses a "#rrggbb" or "rrggbb" color string.
+func parseHexColor(hex string) (rgb, bool) {
+	hex = strings.TrimPrefix(hex, "#")
+	if len(hex) != 6 {
+		return rgb{}, false
+	}
+	value, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return rgb{}, false
+	}
+	return rgb{uint8(value >> 16), uint8(value >> 8), uint8(value)}, true
+}
+
+// code returns the 24-bit ("truecolor") foreground escape sequence.
+func (c rgb) code() string {
+	return fmt.Sprintf("\033[38;2;%d;%d;%dm", c.r, c.g, c.b)
+}
+
+// blend interpolates between c and other; t ranges from 0 (c) to 1 (other).
+func (c rgb) blend(other rgb, t float64) rgb {
+	mix := func(a, b uint8) uint8 {
+		return uint8(float64(a) + (float64(b)-float64(a))*t + 0.5)
+	}
+	return rgb{mix(c.r, other.r), mix(c.g, other.g), mix(c.b, other.b)}
+}
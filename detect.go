@@ -1,6 +1,7 @@
 package colorbear
 
 import (
+	"io"
 	"os"
 	"runtime"
 	"strings"
@@ -47,7 +48,7 @@ func ForceColors(enabled bool) {
 	forceColors = &enabled
 }
 
-// isColorEnabled checks if colors should be used.
+// isColorEnabled checks if colors should be used for stdout.
 //
 // It considers multiple factors in this priority order:
 //  1. User override via ForceColors()
@@ -60,6 +61,16 @@ func ForceColors(enabled bool) {
 // forceColors must be read under an RLock because Spinner Goroutines
 // might read this concurrently with ForceColors() modifying it.
 func isColorEnabled() bool {
+	return isColorEnabledFor(os.Stdout)
+}
+
+// isColorEnabledFor checks if colors should be used when writing to w.
+//
+// It applies the same rules as isColorEnabled, but checks whether w
+// (instead of stdout) is a terminal. Components with a configurable
+// writer use this so that e.g. output to stderr or a buffer is detected
+// correctly.
+func isColorEnabledFor(w io.Writer) bool {
 	mu.RLock()
 	fc := forceColors // Make a local copy while holding the lock
 	mu.RUnlock()
@@ -74,8 +85,8 @@ func isColorEnabled() bool {
 		return false
 	}
 
-	// Check if stdout is a terminal
-	if !isTerminal() {
+	// Check if the output is a terminal
+	if !isTerminalWriter(w) {
 		return false
 	}
 
@@ -98,7 +109,19 @@ func isColorEnabled() bool {
 // When output is piped to a file or another program,
 // colors should be disabled.
 func isTerminal() bool {
-	fileInfo, err := os.Stdout.Stat()
+	return isTerminalWriter(os.Stdout)
+}
+
+// isTerminalWriter checks if w writes to a terminal (TTY).
+//
+// Only *os.File values can be terminals; buffers, pipes and network
+// connections always report false.
+func isTerminalWriter(w io.Writer) bool {
+	file, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	fileInfo, err := file.Stat()
 	if err != nil {
 		return false
 	}
@@ -1,6 +1,7 @@
 package colorbear
 
 import (
+	"bytes"
 	"os"
 	"testing"
 )
@@ -60,3 +61,21 @@ func TestCI(t *testing.T) {
 	// Reset
 	os.Unsetenv("CI")
 }
+
+func TestIsColorEnabledFor(t *testing.T) {
+	var buf bytes.Buffer
+
+	forceColors = nil
+	noColor = false
+	if isColorEnabledFor(&buf) {
+		t.Error("Colors should be disabled for non-terminal writers")
+	}
+
+	ForceColors(true)
+	if !isColorEnabledFor(&buf) {
+		t.Error("ForceColors(true) should override writer detection")
+	}
+
+	// Reset
+	forceColors = nil
+}
@@ -53,7 +53,7 @@ func simpleProgress() {
 	bar := colorbear.NewProgress(100)
 
 	// Simulate processing 100 items
-	for i := 0; i <= 100; i++ {
+	for i := int64(0); i <= 100; i++ {
 		bar.Set(i)
 		time.Sleep(30 * time.Millisecond) // Simulate work
 	}
@@ -78,7 +78,7 @@ func progressWithStats() {
 	)
 
 	// Simulate processing 1000 items quickly
-	for i := 0; i <= 1000; i++ {
+	for i := int64(0); i <= 1000; i++ {
 		bar.Set(i)
 		time.Sleep(5 * time.Millisecond) // Fast processing
 	}
@@ -104,7 +104,7 @@ func downloadSimulation() {
 
 	// Create initial progress bar
 	bar := colorbear.NewProgress(
-		len(files),
+		int64(len(files)),
 		colorbear.WithPrefix("Downloading:"),
 		colorbear.WithCount(true), // Show which file we're on
 	)
@@ -112,18 +112,18 @@ func downloadSimulation() {
 	// Process each file
 	for i, file := range files {
 		// Show progress at start of current file
-		bar.Set(i)
+		bar.Set(int64(i))
 		time.Sleep(500 * time.Millisecond) // Simulate download time
 
 		// Create new bar with updated prefix showing current file
 		// Note: In a real application, you might want to use a single bar
 		// and update just the prefix, but this demonstrates the flexibility
 		bar = colorbear.NewProgress(
-			len(files),
+			int64(len(files)),
 			colorbear.WithPrefix("Downloading: "+file),
 			colorbear.WithCount(true),
 		)
-		bar.Set(i + 1) // Update to show completed file
+		bar.Set(int64(i + 1)) // Update to show completed file
 	}
 
 	bar.Finish("All files downloaded!")
@@ -145,7 +145,7 @@ func customColorProgress() {
 	)
 
 	// Simulate a build process
-	for i := 0; i <= 100; i++ {
+	for i := int64(0); i <= 100; i++ {
 		bar.Set(i)
 		time.Sleep(20 * time.Millisecond)
 	}
@@ -171,7 +171,7 @@ func progressWithError() {
 	)
 
 	// Simulate upload that fails at 50%
-	for i := 0; i <= 50; i++ {
+	for i := int64(0); i <= 50; i++ {
 		bar.Set(i)
 		time.Sleep(30 * time.Millisecond)
 
@@ -70,6 +70,10 @@ func tableStyles() {
 		colorbear.TableStyleBold,
 		colorbear.TableStyleMinimal,
 		colorbear.TableStyleCompact,
+		colorbear.TableStyleMarkdown,
+		colorbear.TableStyleHeavyHeader,
+		colorbear.TableStyleDashed,
+		colorbear.TableStyleBlock,
 	}
 
 	for _, style := range styles {
@@ -0,0 +1,271 @@
+// multiprogress.go
+package colorbear
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// MultiProgress renders several progress bars at once, each on its own line.
+//
+// The container owns the output writer and redraws the whole block in place
+// whenever one of its bars changes, so bars updated from different goroutines
+// never clobber each other. Bars can be added and removed at any time.
+//
+// Completed bars can optionally be pinned: they are printed once above the
+// live area and no longer take part in redraws, which keeps the live block
+// small when many tasks run one after another.
+//
+// Example:
+//
+//	multi := colorbear.NewMultiProgress()
+//	for _, file := range files {
+//	    bar := multi.Add(file.Size, colorbear.WithPrefix(file.Name))
+//	    go func() {
+//	        download(file, bar)
+//	        bar.Finish("done")
+//	    }()
+//	}
+//	wg.Wait()
+//	multi.Stop()
+type MultiProgress struct {
+	bars         []*ProgressBar // Bars in the live area, in display order
+	area         liveArea       // In-place renderer for the live area
+	writer       io.Writer      // Output writer (default: os.Stdout)
+	pinCompleted bool           // Whether finished bars move above the live area
+	logMode      *bool          // Print finished bars instead of redrawing (nil: auto-detect)
+	stopped      bool           // Whether Stop() was called
+	mu           sync.Mutex     // Serializes redraws
+}
+
+// MultiProgressOption is a functional option for configuring a MultiProgress.
+type MultiProgressOption func(*MultiProgress)
+
+// NewMultiProgress creates an empty multi-bar container.
+//
+// By default output goes to os.Stdout and completed bars stay in place.
+//
+// Example:
+//
+//	multi := colorbear.NewMultiProgress(
+//	    colorbear.WithPinCompleted(true),
+//	)
+func NewMultiProgress(opts ...MultiProgressOption) *MultiProgress {
+	m := &MultiProgress{
+		bars:   []*ProgressBar{},
+		writer: os.Stdout,
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	m.area.writer = m.writer
+	return m
+}
+
+// WithMultiWriter sets the output writer for all bars in the container.
+//
+// Example:
+//
+//	multi := colorbear.NewMultiProgress(colorbear.WithMultiWriter(os.Stderr))
+func WithMultiWriter(w io.Writer) MultiProgressOption {
+	return func(m *MultiProgress) {
+		m.writer = w
+	}
+}
+
+// WithPinCompleted controls whether finished bars are pinned above the
+// live area.
+//
+// Pinned bars are printed once (together with their completion message)
+// and removed from the redrawn block.
+//
+// Example:
+//
+//	multi := colorbear.NewMultiProgress(colorbear.WithPinCompleted(true))
+func WithPinCompleted(pin bool) MultiProgressOption {
+	return func(m *MultiProgress) {
+		m.pinCompleted = pin
+	}
+}
+
+// WithMultiLogMode controls whether the container prints a line for every
+// finished bar instead of redrawing the live area in place.
+//
+// By default log mode is chosen automatically when the output writer is
+// not a terminal or a CI environment is detected, like WithLogMode for a
+// single bar.
+//
+// Example:
+//
+//	multi := colorbear.NewMultiProgress(colorbear.WithMultiLogMode(true))
+func WithMultiLogMode(enabled bool) MultiProgressOption {
+	return func(m *MultiProgress) {
+		m.logMode = &enabled
+	}
+}
+
+// Add creates a new progress bar and appends it to the container.
+//
+// It accepts the same options as NewProgress. The returned bar is updated
+// as usual with Set(), Increment(), Add(), Finish() or FinishWithError().
+//
+// Example:
+//
+//	bar := multi.Add(100, colorbear.WithPrefix("worker 1"))
+func (m *MultiProgress) Add(total int64, opts ...ProgressOption) *ProgressBar {
+	return m.AddBar(NewProgress(total, opts...))
+}
+
+// AddBar appends an existing progress bar to the container.
+//
+// Use this for bars created with NewBytesProgress or other constructors.
+//
+// Example:
+//
+//	bar := multi.AddBar(colorbear.NewBytesProgress(size))
+//	io.Copy(dst, bar.Reader(src))
+func (m *MultiProgress) AddBar(pb *ProgressBar) *ProgressBar {
+	m.mu.Lock()
+	pb.mu.Lock()
+	pb.multi = m
+	pb.writer = m.writer // Colors follow the container's writer
+	pb.mu.Unlock()
+	m.bars = append(m.bars, pb)
+	m.mu.Unlock()
+
+	m.redraw()
+	return pb
+}
+
+// Remove takes a bar out of the live area.
+//
+// The bar's line disappears on the next redraw. The bar is detached from
+// the container: later updates and Finish() draw it like a standalone bar.
+func (m *MultiProgress) Remove(pb *ProgressBar) {
+	m.mu.Lock()
+	m.removeBar(pb)
+	m.mu.Unlock()
+
+	pb.mu.Lock()
+	if pb.multi == m {
+		pb.multi = nil
+	}
+	pb.mu.Unlock()
+
+	m.redraw()
+}
+
+// removeBar deletes pb from the bar list. The caller must hold m.mu.
+func (m *MultiProgress) removeBar(pb *ProgressBar) {
+	for i, bar := range m.bars {
+		if bar == pb {
+			m.bars = append(m.bars[:i], m.bars[i+1:]...)
+			return
+		}
+	}
+}
+
+// Stop finishes rendering.
+//
+// The live area is drawn one last time and left on screen. Later updates
+// to any of the bars are ignored. Always call Stop() once all work is done.
+func (m *MultiProgress) Stop() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.stopped {
+		return
+	}
+
+	if m.live() {
+		m.area.update(nil, m.liveLines())
+	} else {
+		// Without a terminal only finished bars were printed so far
+		for _, bar := range m.bars {
+			bar.mu.Lock()
+			finished := bar.finished
+			bar.mu.Unlock()
+
+			if !finished {
+				fmt.Fprintln(m.writer, bar.line())
+			}
+		}
+	}
+	m.stopped = true
+}
+
+// redraw renders the live area with the current state of all bars.
+func (m *MultiProgress) redraw() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// Cursor movement is not possible without a terminal: in that case
+	// only completed bars are printed (see complete).
+	if m.stopped || !m.live() {
+		return
+	}
+	m.area.update(nil, m.liveLines())
+}
+
+// complete handles Finish() and FinishWithError() for a managed bar.
+//
+// kind is the semantic kind (success or error) used for the message.
+func (m *MultiProgress) complete(pb *ProgressBar, message string, kind messageKind) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.stopped {
+		return
+	}
+
+	formatted := ""
+	if message != "" {
+		formatted = kind.format(message, isColorEnabledFor(m.writer))
+	}
+
+	if !m.live() {
+		output := pb.line()
+		if formatted != "" {
+			output += " " + formatted
+		}
+		fmt.Fprintln(m.writer, output)
+		return
+	}
+
+	if !m.pinCompleted {
+		pb.mu.Lock()
+		pb.completeMsg = formatted
+		pb.mu.Unlock()
+		m.area.update(nil, m.liveLines())
+		return
+	}
+
+	pinned := []string{pb.line()}
+	if formatted != "" {
+		pinned = append(pinned, formatted)
+	}
+	m.removeBar(pb)
+	m.area.update(pinned, m.liveLines())
+}
+
+// live reports whether the live area is redrawn in place: the writer is a
+// terminal outside CI, unless log mode was set with WithMultiLogMode.
+func (m *MultiProgress) live() bool {
+	if m.logMode != nil {
+		return !*m.logMode
+	}
+	return isTerminalWriter(m.writer) && !isCI()
+}
+
+// liveLines renders all bars in the live area. The caller must hold m.mu.
+func (m *MultiProgress) liveLines() []string {
+	lines := make([]string, 0, len(m.bars))
+	for _, bar := range m.bars {
+		lines = append(lines, bar.line())
+	}
+	return lines
+}
@@ -0,0 +1,137 @@
+package colorbear
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestMultiProgressAddRemove(t *testing.T) {
+	ForceColors(false)
+	var buf bytes.Buffer
+	multi := NewMultiProgress(WithMultiWriter(&buf))
+
+	bar1 := multi.Add(10, WithPrefix("one"))
+	bar2 := multi.AddBar(NewBytesProgress(1024, WithPrefix("two")))
+
+	if len(multi.bars) != 2 {
+		t.Fatalf("Expected 2 bars, got %d", len(multi.bars))
+	}
+	if bar1.multi != multi || bar2.multi != multi {
+		t.Error("Bars should be attached to the container")
+	}
+
+	multi.Remove(bar1)
+	if len(multi.bars) != 1 || multi.bars[0] != bar2 {
+		t.Error("Remove should only remove the given bar")
+	}
+	if bar1.multi != nil {
+		t.Error("Removed bar should be detached from the container")
+	}
+}
+
+func TestMultiProgressRedrawWithoutColors(t *testing.T) {
+	ForceColors(false)
+
+	var buf bytes.Buffer
+	multi := NewMultiProgress(WithMultiWriter(&buf), WithMultiLogMode(false))
+	bar := multi.Add(10, WithPrefix("one"))
+
+	buf.Reset()
+	bar.Set(5)
+	if !strings.HasPrefix(buf.String(), "\033[1A") {
+		t.Errorf("Live area should be redrawn in place without colors, got %q", buf.String())
+	}
+}
+
+func TestMultiProgressRedraw(t *testing.T) {
+	ForceColors(true)
+	defer ForceColors(false)
+
+	var buf bytes.Buffer
+	multi := NewMultiProgress(WithMultiWriter(&buf), WithMultiLogMode(false))
+	bar1 := multi.Add(10, WithPrefix("one"))
+	multi.Add(10, WithPrefix("two"))
+
+	buf.Reset()
+	bar1.Set(5)
+	output := buf.String()
+
+	if !strings.HasPrefix(output, "\033[2A") {
+		t.Errorf("Redraw should move the cursor up over both bars, got %q", output)
+	}
+	if !strings.Contains(output, "one") || !strings.Contains(output, "two") {
+		t.Error("Redraw should contain all bars")
+	}
+}
+
+func TestMultiProgressPinCompleted(t *testing.T) {
+	ForceColors(true)
+	defer ForceColors(false)
+
+	var buf bytes.Buffer
+	multi := NewMultiProgress(WithMultiWriter(&buf), WithPinCompleted(true), WithMultiLogMode(false))
+	bar1 := multi.Add(10, WithPrefix("one"))
+	multi.Add(10, WithPrefix("two"))
+
+	bar1.Finish("first done")
+
+	if len(multi.bars) != 1 {
+		t.Errorf("Pinned bar should leave the live area, got %d bars", len(multi.bars))
+	}
+	if multi.area.lines != 1 {
+		t.Errorf("Expected 1 live line, got %d", multi.area.lines)
+	}
+	if !strings.Contains(buf.String(), "first done") {
+		t.Error("Completion message should be printed")
+	}
+}
+
+func TestMultiProgressConcurrent(t *testing.T) {
+	ForceColors(false)
+	var buf bytes.Buffer
+	multi := NewMultiProgress(WithMultiWriter(&buf))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		bar := multi.Add(100)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				bar.Increment()
+			}
+			bar.Finish("")
+		}()
+	}
+	wg.Wait()
+	multi.Stop()
+
+	if lines := strings.Count(buf.String(), "\n"); lines != 4 {
+		t.Errorf("Expected 4 completion lines without a terminal, got %d", lines)
+	}
+}
+
+func TestMultiProgressUsesItsWriter(t *testing.T) {
+	mu.Lock()
+	forceColors = nil // Auto-detect from the writer
+	mu.Unlock()
+	defer ForceColors(false)
+
+	var buf bytes.Buffer
+	multi := NewMultiProgress(WithMultiWriter(&buf))
+	bar := multi.Add(10, WithPrefix("one"))
+
+	if bar.writer != &buf {
+		t.Error("Bars should write to the container's writer")
+	}
+
+	bar.Set(5)
+	bar.Finish("done")
+	multi.Stop()
+
+	if output := buf.String(); strings.Contains(output, "\033[") {
+		t.Errorf("Output to a non-terminal writer should have no escape codes, got %q", output)
+	}
+}
@@ -3,7 +3,10 @@ package colorbear
 
 import (
 	"fmt"
+	"io"
+	"os"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -15,17 +18,34 @@ import (
 // Progress bars automatically handle terminal width, color detection,
 // and gracefully degrade in non-TTY environments.
 type ProgressBar struct {
-	total       int       // Total number of items to process
-	current     int       // Current progress (0 to total)
-	width       int       // Width of the progress bar in characters
-	prefix      string    // Text to display before the bar
-	showPercent bool      // Whether to show percentage
-	showCount   bool      // Whether to show current/total count
-	showTime    bool      // Whether to show elapsed time
-	startTime   time.Time // When the progress bar was created
-	lastDraw    string    // Last drawn output (for clearing)
-	color       string    // ANSI color code for the filled portion
-	completeMsg string    // Message to show on completion (unused currently)
+	total       int64           // Total number of items (or bytes) to process
+	current     int64           // Current progress (0 to total)
+	width       int             // Width of the progress bar in characters
+	prefix      string          // Text to display before the bar
+	showPercent bool            // Whether to show percentage
+	showCount   bool            // Whether to show current/total count
+	showTime    bool            // Whether to show elapsed time
+	showRate    bool            // Whether to show throughput (items/s or bytes/s)
+	bytes       bool            // Whether values are byte counts (human-readable units)
+	startTime   time.Time       // When the progress bar was created
+	lastDraw    string          // Last drawn output (for clearing)
+	color       string          // ANSI color code for the filled portion
+	completeMsg string          // Message shown next to a finished bar inside a MultiProgress
+	finished    bool            // Whether Finish() or FinishWithError() was called
+	tick        int             // Animation step for the indeterminate marquee
+	glyphs      *ProgressGlyphs // Characters used to draw the bar
+	template    string          // Layout template (empty: default layout)
+	gradient    []rgb           // Start and end color for gradient fills
+	logMode     *bool           // Log-friendly output (nil: auto-detect)
+	logStep     float64         // Percentage step between log lines
+	logInterval time.Duration   // Maximum time between log lines
+	logged      bool            // Whether a log line was written yet
+	lastLog     time.Time       // When the last log line was written
+	lastLogPct  float64         // Percentage of the last log line
+	lastLogCur  int64           // Progress value of the last log line
+	writer      io.Writer       // Output writer (default: os.Stdout)
+	multi       *MultiProgress
+	mu          sync.Mutex // Protects progress state from concurrent updates
 }
 
 // ProgressOption is a functional option for configuring a ProgressBar.
@@ -38,6 +58,10 @@ type ProgressOption func(*ProgressBar)
 // The total represents the number of items or steps to complete.
 // Progress is updated using Set(), Increment(), or Add() methods.
 //
+// A total of zero or less creates an indeterminate progress bar: instead of
+// a percentage it shows a bouncing marquee until the total becomes known
+// via SetTotal().
+//
 // By default, the progress bar:
 //   - Shows percentage (disable with WithPercent(false))
 //   - Uses cyan color (change with WithColor())
@@ -47,12 +71,16 @@ type ProgressOption func(*ProgressBar)
 // Example:
 //
 //	bar := colorbear.NewProgress(100)
-//	for i := 0; i <= 100; i++ {
+//	for i := int64(0); i <= 100; i++ {
 //	    bar.Set(i)
 //	    time.Sleep(50 * time.Millisecond)
 //	}
 //	bar.Finish("Done!")
 //
+// When the output is not a terminal (piped output, CI/CD log files), the
+// bar switches to log mode and prints a plain line every 10% or 30s
+// instead of redrawing in place (see WithLogMode).
+//
 // Example with options:
 //
 //	bar := colorbear.NewProgress(1000,
@@ -60,7 +88,7 @@ type ProgressOption func(*ProgressBar)
 //	    colorbear.WithCount(true),
 //	    colorbear.WithTime(true),
 //	)
-func NewProgress(total int, opts ...ProgressOption) *ProgressBar {
+func NewProgress(total int64, opts ...ProgressOption) *ProgressBar {
 	pb := &ProgressBar{
 		total:       total,
 		current:     0,
@@ -71,6 +99,10 @@ func NewProgress(total int, opts ...ProgressOption) *ProgressBar {
 		showTime:    false,
 		startTime:   time.Now(),
 		color:       CyanCode,
+		glyphs:      ProgressGlyphsBlock,
+		logStep:     10,
+		logInterval: 30 * time.Second,
+		writer:      os.Stdout,
 	}
 
 	// Apply all provided options
@@ -81,6 +113,27 @@ func NewProgress(total int, opts ...ProgressOption) *ProgressBar {
 	return pb
 }
 
+// NewBytesProgress creates a progress bar that tracks a number of bytes.
+//
+// Counts are displayed in human-readable binary units (B, KiB, MiB, GiB)
+// and the transfer rate is shown by default. Combine it with Reader() or
+// Writer() to advance the bar automatically while data is copied.
+//
+// Example:
+//
+//	bar := colorbear.NewBytesProgress(resp.ContentLength,
+//	    colorbear.WithPrefix("Downloading:"),
+//	)
+//	io.Copy(file, bar.Reader(resp.Body))
+//	bar.Finish("Download complete!")
+//	// Output: Downloading: [████████░░░░] 50% (5.0 MiB/10.0 MiB) 2.1 MiB/s
+func NewBytesProgress(totalBytes int64, opts ...ProgressOption) *ProgressBar {
+	defaults := []ProgressOption{WithCount(true), WithRate(true)}
+	pb := NewProgress(totalBytes, append(defaults, opts...)...)
+	pb.bytes = true
+	return pb
+}
+
 // WithWidth sets the width of the progress bar in characters.
 //
 // The default width is 40 characters. Larger widths provide more granular
@@ -149,6 +202,88 @@ func WithTime(show bool) ProgressOption {
 	}
 }
 
+// WithRate shows the throughput since the progress bar was created.
+//
+// Byte progress bars display the rate in binary units per second
+// (e.g., "2.1 MiB/s"), all other bars in items per second (e.g., "12.5/s").
+//
+// Example:
+//
+//	bar := colorbear.NewProgress(1000, colorbear.WithRate(true))
+//	// Output: [████████░░░░] 50% 12.5/s
+func WithRate(show bool) ProgressOption {
+	return func(pb *ProgressBar) {
+		pb.showRate = show
+	}
+}
+
+// WithProgressWriter sets the output writer for the progress bar.
+//
+// The default writer is os.Stdout. Bars added to a MultiProgress are drawn
+// by the container and use its writer instead.
+//
+// Example:
+//
+//	bar := colorbear.NewProgress(100, colorbear.WithProgressWriter(os.Stderr))
+func WithProgressWriter(w io.Writer) ProgressOption {
+	return func(pb *ProgressBar) {
+		pb.writer = w
+	}
+}
+
+// WithLogMode forces log-friendly output on or off.
+//
+// In log mode the bar is not redrawn in place with carriage returns.
+// Instead a plain, newline-terminated line is written whenever progress
+// crosses a percentage step (see WithLogStep) or the log interval has
+// passed (see WithLogInterval), and once more when the bar finishes.
+//
+// By default log mode is chosen automatically when the output writer
+// is not a terminal or a CI environment is detected.
+//
+// Example:
+//
+//	bar := colorbear.NewProgress(100, colorbear.WithLogMode(true))
+//	// Output:
+//	// [░░░░░░░░░░░░░░░░░░░░░░░░░░░░░░░░░░░░░░░░] 0%
+//	// [████░░░░░░░░░░░░░░░░░░░░░░░░░░░░░░░░░░░░] 10%
+//	// ...
+func WithLogMode(enabled bool) ProgressOption {
+	return func(pb *ProgressBar) {
+		pb.logMode = &enabled
+	}
+}
+
+// WithLogStep sets the percentage step between log lines (default: 10).
+//
+// A step of 0 disables percentage-based logging, leaving only the
+// time interval.
+//
+// Example:
+//
+//	bar := colorbear.NewProgress(100, colorbear.WithLogStep(25))
+//	// Logs at 0%, 25%, 50%, 75% and 100%
+func WithLogStep(percent float64) ProgressOption {
+	return func(pb *ProgressBar) {
+		pb.logStep = percent
+	}
+}
+
+// WithLogInterval sets the maximum time between log lines (default: 30s).
+//
+// This keeps slow tasks visible in logs even when progress does not cross
+// a percentage step, and is the only trigger for indeterminate bars.
+// An interval of 0 disables time-based logging.
+//
+// Example:
+//
+//	bar := colorbear.NewProgress(100, colorbear.WithLogInterval(time.Minute))
+func WithLogInterval(interval time.Duration) ProgressOption {
+	return func(pb *ProgressBar) {
+		pb.logInterval = interval
+	}
+}
+
 // WithColor sets the color of the filled portion of the progress bar.
 //
 // Use one of the ColorCode constants (RedCode, GreenCode, etc.).
@@ -170,12 +305,15 @@ func WithColor(color string) ProgressOption {
 // Example:
 //
 //	bar := colorbear.NewProgress(100)
-//	for i := 0; i <= 100; i++ {
+//	for i := int64(0); i <= 100; i++ {
 //	    bar.Set(i)
 //	    time.Sleep(50 * time.Millisecond)
 //	}
-func (pb *ProgressBar) Set(current int) {
+func (pb *ProgressBar) Set(current int64) {
+	pb.mu.Lock()
 	pb.current = current
+	pb.tick++
+	pb.mu.Unlock()
 	pb.draw()
 }
 
@@ -192,48 +330,177 @@ func (pb *ProgressBar) Set(current int) {
 //	    processItem(i)
 //	}
 func (pb *ProgressBar) Increment() {
-	pb.current++
-	if pb.current > pb.total {
-		pb.current = pb.total
-	}
-	pb.draw()
+	pb.Add(1)
 }
 
 // Add increases the progress by a specific amount.
 //
 // This is useful when processing items in batches.
-// The progress is automatically capped at the total.
+// The progress is automatically capped at the total (unless the bar
+// is indeterminate).
 //
 // Example:
 //
 //	bar := colorbear.NewProgress(1000)
 //	for batch := range batches {
 //	    processBatch(batch)
-//	    bar.Add(len(batch)) // Add batch size to progress
+//	    bar.Add(int64(len(batch))) // Add batch size to progress
 //	}
-func (pb *ProgressBar) Add(amount int) {
+func (pb *ProgressBar) Add(amount int64) {
+	pb.mu.Lock()
 	pb.current += amount
-	if pb.current > pb.total {
+	if !pb.indeterminate() && pb.current > pb.total {
 		pb.current = pb.total
 	}
+	pb.tick++
+	pb.mu.Unlock()
+	pb.draw()
+}
+
+// SetTotal changes the total of the progress bar.
+//
+// Use this when the total only becomes known after work has started
+// (e.g., once a Content-Length header arrives). Setting a positive total
+// turns an indeterminate bar into a regular one; zero or a negative
+// value switches back to indeterminate mode.
+//
+// Example:
+//
+//	bar := colorbear.NewBytesProgress(0) // size unknown yet
+//	resp := fetch()
+//	bar.SetTotal(resp.ContentLength)
+func (pb *ProgressBar) SetTotal(total int64) {
+	pb.mu.Lock()
+	pb.total = total
+	pb.mu.Unlock()
+	pb.draw()
+}
+
+// Tick advances the indeterminate animation without changing progress.
+//
+// Call it periodically while waiting on work that reports no progress
+// to keep the marquee moving. For regular bars it simply redraws.
+//
+// Example:
+//
+//	bar := colorbear.NewProgress(0, colorbear.WithPrefix("Waiting:"))
+//	for !done() {
+//	    bar.Tick()
+//	    time.Sleep(100 * time.Millisecond)
+//	}
+func (pb *ProgressBar) Tick() {
+	pb.mu.Lock()
+	pb.tick++
+	pb.mu.Unlock()
 	pb.draw()
 }
 
+// indeterminate reports whether the total is unknown (zero or negative).
+func (pb *ProgressBar) indeterminate() bool {
+	return pb.total <= 0
+}
+
+// percent returns the completion percentage, clamped to 0-100.
+//
+// Indeterminate bars always report 0 to avoid dividing by zero.
+func (pb *ProgressBar) percent() float64 {
+	if pb.indeterminate() {
+		return 0
+	}
+	p := float64(pb.current) / float64(pb.total) * 100
+	if p < 0 {
+		return 0
+	}
+	if p > 100 {
+		return 100
+	}
+	return p
+}
+
 // draw renders the progress bar to the terminal.
 //
 // This method is called internally by Set(), Increment(), and Add().
 // It handles both colored and non-colored output based on terminal capabilities.
+// Bars managed by a MultiProgress are redrawn by their container instead.
 func (pb *ProgressBar) draw() {
-	// Simple fallback for non-TTY environments (piped output, CI/CD, etc.)
-	if !isColorEnabled() {
-		percent := float64(pb.current) / float64(pb.total) * 100
-		fmt.Printf("\r%s%.0f%% (%d/%d)", pb.prefix, percent, pb.current, pb.total)
+	pb.mu.Lock()
+	if multi := pb.multi; multi != nil {
+		pb.mu.Unlock()
+		multi.redraw()
 		return
 	}
+	defer pb.mu.Unlock()
 
-	// Calculate how much of the bar should be filled
-	percent := float64(pb.current) / float64(pb.total) * 100
-	filled := int(float64(pb.width) * float64(pb.current) / float64(pb.total))
+	// Line-based output for non-TTY environments (piped output, CI/CD, etc.)
+	if pb.useLogMode() {
+		pb.drawLog()
+		return
+	}
+
+	// Print the bar, clearing any leftover characters from previous draw
+	output := pb.render()
+	fmt.Fprint(pb.writer, "\r"+output+strings.Repeat(" ", maxInt(0, len(pb.lastDraw)-len(output))))
+	pb.lastDraw = output
+}
+
+// useLogMode reports whether the bar writes log lines instead of
+// redrawing in place.
+func (pb *ProgressBar) useLogMode() bool {
+	if pb.logMode != nil {
+		return *pb.logMode
+	}
+	return !isTerminalWriter(pb.writer) || isCI()
+}
+
+// drawLog writes a plain log line if one is due. The caller must hold pb.mu.
+//
+// A line is due for the first draw, when progress crosses a percentage
+// step, when the log interval has passed, and when the bar finishes
+// with progress not yet logged.
+func (pb *ProgressBar) drawLog() {
+	now := time.Now()
+	percent := pb.percent()
+
+	crossedStep := pb.logStep > 0 && !pb.indeterminate() &&
+		int(percent/pb.logStep) > int(pb.lastLogPct/pb.logStep)
+	intervalPassed := pb.logInterval > 0 && now.Sub(pb.lastLog) >= pb.logInterval
+	finalUpdate := pb.finished && pb.current != pb.lastLogCur
+
+	if pb.logged && !crossedStep && !intervalPassed && !finalUpdate {
+		return
+	}
+
+	fmt.Fprintln(pb.writer, stripANSI(pb.render()))
+	pb.logged = true
+	pb.lastLog = now
+	pb.lastLogPct = percent
+	pb.lastLogCur = pb.current
+}
+
+// colored reports whether colors are enabled for the bar's writer.
+func (pb *ProgressBar) colored() bool {
+	return isColorEnabledFor(pb.writer)
+}
+
+// line returns the current bar as a single line of text.
+//
+// It is safe to call concurrently with updates and is used by MultiProgress.
+func (pb *ProgressBar) line() string {
+	pb.mu.Lock()
+	defer pb.mu.Unlock()
+
+	output := pb.render()
+	if pb.finished && pb.completeMsg != "" {
+		output += " " + pb.completeMsg
+	}
+	return output
+}
+
+// render builds the progress bar string. The caller must hold pb.mu.
+func (pb *ProgressBar) render() string {
+	if pb.template != "" {
+		return pb.renderTemplate()
+	}
 
 	// Build the progress bar string
 	var bar strings.Builder
@@ -245,19 +512,21 @@ func (pb *ProgressBar) draw() {
 	}
 
 	// Draw the bar itself with filled and empty portions
-	bar.WriteString("[")
-	bar.WriteString(colorize(strings.Repeat("█", filled), pb.color))
-	bar.WriteString(strings.Repeat("░", pb.width-filled))
-	bar.WriteString("]")
+	bar.WriteString(pb.barText())
 
-	// Add percentage if enabled
-	if pb.showPercent {
-		bar.WriteString(fmt.Sprintf(" %.0f%%", percent))
+	// Add percentage if enabled (unknown for indeterminate bars)
+	if pb.showPercent && !pb.indeterminate() {
+		bar.WriteString(" " + formatPercent(pb.percent()))
 	}
 
 	// Add count if enabled
 	if pb.showCount {
-		bar.WriteString(fmt.Sprintf(" (%d/%d)", pb.current, pb.total))
+		bar.WriteString(" (" + pb.countText() + ")")
+	}
+
+	// Add throughput if enabled
+	if pb.showRate {
+		bar.WriteString(" " + pb.rateText())
 	}
 
 	// Add elapsed time if enabled
@@ -266,16 +535,14 @@ func (pb *ProgressBar) draw() {
 		bar.WriteString(fmt.Sprintf(" - %s", formatDuration(elapsed)))
 	}
 
-	// Print the bar, clearing any leftover characters from previous draw
-	output := bar.String()
-	fmt.Print("\r" + output + strings.Repeat(" ", maxInt(0, len(pb.lastDraw)-len(output))))
-	pb.lastDraw = output
+	return bar.String()
 }
 
 // Finish completes the progress bar and displays a success message.
 //
 // This method sets progress to 100%, prints a newline, and displays
 // the provided message using the Success style (green with checkmark).
+// Indeterminate bars keep their count and are drawn completely filled.
 //
 // Always call Finish() or FinishWithError() when done to ensure
 // proper terminal output.
@@ -283,18 +550,32 @@ func (pb *ProgressBar) draw() {
 // Example:
 //
 //	bar := colorbear.NewProgress(100)
-//	for i := 0; i <= 100; i++ {
+//	for i := int64(0); i <= 100; i++ {
 //	    bar.Set(i)
 //	    processItem(i)
 //	}
 //	bar.Finish("All items processed!")
 func (pb *ProgressBar) Finish(message string) {
-	pb.current = pb.total
+	pb.mu.Lock()
+	if !pb.indeterminate() {
+		pb.current = pb.total
+	}
+	pb.finished = true
+	multi := pb.multi
+	pb.mu.Unlock()
+
+	if multi != nil {
+		multi.complete(pb, message, successKind)
+		return
+	}
+
 	pb.draw()
-	fmt.Println() // Move to new line
+	if !pb.useLogMode() {
+		fmt.Fprintln(pb.writer) // Move to new line
+	}
 
 	if message != "" {
-		SuccessPrint(message)
+		fmt.Fprintln(pb.writer, successKind.format(message, pb.colored()))
 	}
 }
 
@@ -308,7 +589,7 @@ func (pb *ProgressBar) Finish(message string) {
 // Example:
 //
 //	bar := colorbear.NewProgress(100)
-//	for i := 0; i <= 100; i++ {
+//	for i := int64(0); i <= 100; i++ {
 //	    bar.Set(i)
 //	    if err := processItem(i); err != nil {
 //	        bar.FinishWithError("Processing failed: " + err.Error())
@@ -316,8 +597,122 @@ func (pb *ProgressBar) Finish(message string) {
 //	    }
 //	}
 func (pb *ProgressBar) FinishWithError(message string) {
-	fmt.Println() // Move to new line
-	ErrorPrint(message)
+	pb.mu.Lock()
+	pb.finished = true
+	multi := pb.multi
+	pb.mu.Unlock()
+
+	if multi != nil {
+		multi.complete(pb, message, errorKind)
+		return
+	}
+
+	if !pb.useLogMode() {
+		fmt.Fprintln(pb.writer) // Move to new line
+	}
+	fmt.Fprintln(pb.writer, errorKind.format(message, pb.colored()))
+}
+
+// countText returns the "current/total" statistic, using byte units
+// for byte progress bars. Indeterminate bars only show the current value.
+func (pb *ProgressBar) countText() string {
+	format := func(n int64) string {
+		if pb.bytes {
+			return formatBytes(n)
+		}
+		return fmt.Sprintf("%d", n)
+	}
+	if pb.indeterminate() {
+		return format(pb.current)
+	}
+	return format(pb.current) + "/" + format(pb.total)
+}
+
+// rateText returns the average throughput since the bar was created.
+func (pb *ProgressBar) rateText() string {
+	elapsed := time.Since(pb.startTime).Seconds()
+	rate := 0.0
+	if elapsed > 0 {
+		rate = float64(pb.current) / elapsed
+	}
+	if pb.bytes {
+		return formatBytes(int64(rate)) + "/s"
+	}
+	return fmt.Sprintf("%.1f/s", rate)
+}
+
+// Reader wraps r so that every successful Read advances the progress bar
+// by the number of bytes read.
+//
+// This is the easiest way to track downloads or file copies with io.Copy.
+//
+// Example:
+//
+//	bar := colorbear.NewBytesProgress(info.Size())
+//	io.Copy(dst, bar.Reader(src))
+//	bar.Finish("Copied!")
+func (pb *ProgressBar) Reader(r io.Reader) io.Reader {
+	return &progressReader{reader: r, bar: pb}
+}
+
+// Writer wraps w so that every successful Write advances the progress bar
+// by the number of bytes written.
+//
+// Example:
+//
+//	bar := colorbear.NewBytesProgress(info.Size())
+//	io.Copy(bar.Writer(dst), src)
+//	bar.Finish("Uploaded!")
+func (pb *ProgressBar) Writer(w io.Writer) io.Writer {
+	return &progressWriter{writer: w, bar: pb}
+}
+
+// progressReader is the io.Reader returned by ProgressBar.Reader.
+type progressReader struct {
+	reader io.Reader
+	bar    *ProgressBar
+}
+
+// Read reads from the underlying reader and advances the bar.
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if n > 0 {
+		r.bar.Add(int64(n))
+	}
+	return n, err
+}
+
+// progressWriter is the io.Writer returned by ProgressBar.Writer.
+type progressWriter struct {
+	writer io.Writer
+	bar    *ProgressBar
+}
+
+// Write writes to the underlying writer and advances the bar.
+func (w *progressWriter) Write(p []byte) (int, error) {
+	n, err := w.writer.Write(p)
+	if n > 0 {
+		w.bar.Add(int64(n))
+	}
+	return n, err
+}
+
+// formatBytes formats a byte count using binary (IEC) units.
+//
+// Returns:
+//   - Plain bytes below 1 KiB (e.g., "512 B")
+//   - One decimal for larger values (e.g., "1.5 KiB", "3.2 MiB", "1.0 GiB")
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit && exp < 5; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
 }
 
 // formatDuration formats a duration in a human-readable way.
@@ -347,3 +742,11 @@ func maxInt(a, b int) int {
 	}
 	return b
 }
+
+// minInt returns the minimum of two integers.
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,268 @@
+// progress_style.go
+package colorbear
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ProgressGlyphs defines the characters used to draw a progress bar.
+//
+// Use one of the predefined glyph sets or create your own. Head and
+// Partials are optional: Head marks the leading edge of the filled portion
+// (e.g., ">" in "[===>---]"), Partials provide sub-character precision by
+// drawing a fraction of a cell.
+type ProgressGlyphs struct {
+	Left     string   // Opening bracket
+	Right    string   // Closing bracket
+	Fill     string   // Completed cell
+	Empty    string   // Remaining cell
+	Head     string   // Leading edge of the filled portion (optional)
+	Partials []string // Fractional cells from smallest to largest (optional)
+	Name     string   // Glyph set name (for debugging)
+}
+
+// Predefined glyph sets
+var (
+	// ProgressGlyphsBlock uses full blocks and light shade.
+	// This is the default glyph set.
+	//
+	// Example: [████████░░░░░░░░]
+	ProgressGlyphsBlock = &ProgressGlyphs{
+		Left:  "[",
+		Right: "]",
+		Fill:  "█",
+		Empty: "░",
+		Name:  "block",
+	}
+
+	// ProgressGlyphsSmooth uses partial block characters so the bar
+	// advances in eighths of a cell.
+	//
+	// Example: [████████▍       ]
+	ProgressGlyphsSmooth = &ProgressGlyphs{
+		Left:     "[",
+		Right:    "]",
+		Fill:     "█",
+		Empty:    " ",
+		Partials: []string{"▏", "▎", "▍", "▌", "▋", "▊", "▉"},
+		Name:     "smooth",
+	}
+
+	// ProgressGlyphsASCII uses plain ASCII characters.
+	// Works in every terminal and log file.
+	//
+	// Example: [=======>--------]
+	ProgressGlyphsASCII = &ProgressGlyphs{
+		Left:  "[",
+		Right: "]",
+		Fill:  "=",
+		Empty: "-",
+		Head:  ">",
+		Name:  "ascii",
+	}
+)
+
+// WithGlyphs sets the characters used to draw the bar.
+//
+// Example:
+//
+//	bar := colorbear.NewProgress(100,
+//	    colorbear.WithGlyphs(colorbear.ProgressGlyphsASCII),
+//	)
+//	// Output: [===================>--------------------] 50%
+func WithGlyphs(glyphs *ProgressGlyphs) ProgressOption {
+	return func(pb *ProgressBar) {
+		if glyphs != nil {
+			pb.glyphs = glyphs
+		}
+	}
+}
+
+// WithTemplate sets a layout template for the progress line.
+//
+// Placeholders are replaced on every draw; text between them is kept as is.
+// Surrounding whitespace is trimmed, so empty placeholders at the start or
+// end of the template leave no gaps.
+//
+// Available placeholders:
+//   - {{prefix}}: The prefix text (see WithPrefix)
+//   - {{bar}}: The bar itself
+//   - {{percent}}: Percentage (e.g., "50%", empty for indeterminate bars)
+//   - {{count}}: Current/total (e.g., "500/1000" or "5.0 MiB/10.0 MiB")
+//   - {{rate}}: Throughput (e.g., "12.5/s" or "2.1 MiB/s")
+//   - {{elapsed}}: Time since the bar was created
+//   - {{eta}}: Estimated time remaining ("--" while unknown)
+//
+// WithPercent, WithCount, WithRate and WithTime have no effect when a
+// template is set.
+//
+// Example:
+//
+//	bar := colorbear.NewProgress(1000,
+//	    colorbear.WithPrefix("Processing:"),
+//	    colorbear.WithTemplate("{{prefix}} {{bar}} {{percent}} | {{count}} | ETA {{eta}}"),
+//	)
+//	// Output: Processing: [████████░░░░░░░░] 50% | 500/1000 | ETA 4.8s
+func WithTemplate(template string) ProgressOption {
+	return func(pb *ProgressBar) {
+		pb.template = template
+	}
+}
+
+// WithGradient fills the bar with a color gradient from start to end.
+//
+// Colors are given as hex strings ("#ff0000" or "ff0000") and rendered
+// as 24-bit colors. Invalid colors are ignored and the regular bar color
+// (see WithColor) is used instead.
+//
+// Example:
+//
+//	bar := colorbear.NewProgress(100,
+//	    colorbear.WithGradient("#ff5f6d", "#ffc371"),
+//	)
+func WithGradient(start, end string) ProgressOption {
+	return func(pb *ProgressBar) {
+		from, ok1 := parseHexColor(start)
+		to, ok2 := parseHexColor(end)
+		if ok1 && ok2 {
+			pb.gradient = []rgb{from, to}
+		}
+	}
+}
+
+// barText draws the bar including brackets. The caller must hold pb.mu.
+func (pb *ProgressBar) barText() string {
+	body := ""
+	switch {
+	case pb.indeterminate() && !pb.finished:
+		body = pb.marquee()
+	case pb.indeterminate():
+		body = pb.fill(1)
+	default:
+		body = pb.fill(pb.percent() / 100)
+	}
+	return pb.glyphs.Left + body + pb.glyphs.Right
+}
+
+// fill draws the bar body for a completion ratio between 0 and 1.
+func (pb *ProgressBar) fill(ratio float64) string {
+	g := pb.glyphs
+	cells := ratio * float64(pb.width)
+	full := minInt(int(cells), pb.width)
+
+	filled := make([]string, 0, full+1)
+	for i := 0; i < full; i++ {
+		filled = append(filled, g.Fill)
+	}
+
+	// The leading edge: a fractional cell, or the head glyph
+	if full < pb.width {
+		if partial := g.partial(cells - float64(full)); partial != "" {
+			filled = append(filled, partial)
+		} else if g.Head != "" && ratio > 0 {
+			filled = append(filled, g.Head)
+		}
+	}
+
+	return pb.colorCells(filled, 0) + strings.Repeat(g.Empty, pb.width-len(filled))
+}
+
+// marquee draws the bouncing segment of an indeterminate bar.
+//
+// The segment is a fifth of the bar width and moves one cell per tick,
+// reversing direction at either end.
+func (pb *ProgressBar) marquee() string {
+	segment := maxInt(1, pb.width/5)
+	span := maxInt(0, pb.width-segment)
+
+	pos := 0
+	if span > 0 {
+		pos = pb.tick % (2 * span)
+		if pos > span {
+			pos = 2*span - pos
+		}
+	}
+
+	cells := make([]string, segment)
+	for i := range cells {
+		cells[i] = pb.glyphs.Fill
+	}
+
+	return strings.Repeat(pb.glyphs.Empty, pos) +
+		pb.colorCells(cells, pos) +
+		strings.Repeat(pb.glyphs.Empty, maxInt(0, pb.width-segment-pos))
+}
+
+// colorCells colors the filled cells of the bar.
+//
+// With a gradient every cell gets the color for its position in the bar
+// (offset is the position of the first cell); otherwise the whole run is
+// colored with the bar color.
+func (pb *ProgressBar) colorCells(cells []string, offset int) string {
+	if len(pb.gradient) < 2 {
+		return colorizeIf(pb.colored(), strings.Join(cells, ""), pb.color)
+	}
+
+	var out strings.Builder
+	for i, cell := range cells {
+		t := 0.0
+		if pb.width > 1 {
+			t = float64(offset+i) / float64(pb.width-1)
+		}
+		out.WriteString(colorizeIf(pb.colored(), cell, pb.gradient[0].blend(pb.gradient[1], t).code()))
+	}
+	return out.String()
+}
+
+// partial returns the glyph for a fractional cell (0 <= frac < 1),
+// or an empty string if the fraction is too small or no partials exist.
+func (g *ProgressGlyphs) partial(frac float64) string {
+	if len(g.Partials) == 0 {
+		return ""
+	}
+	index := int(frac * float64(len(g.Partials)+1))
+	if index <= 0 {
+		return ""
+	}
+	return g.Partials[minInt(index, len(g.Partials))-1]
+}
+
+// renderTemplate builds the progress line from the template.
+// The caller must hold pb.mu.
+func (pb *ProgressBar) renderTemplate() string {
+	percent := ""
+	if !pb.indeterminate() {
+		percent = formatPercent(pb.percent())
+	}
+
+	replacer := strings.NewReplacer(
+		"{{prefix}}", pb.prefix,
+		"{{bar}}", pb.barText(),
+		"{{percent}}", percent,
+		"{{count}}", pb.countText(),
+		"{{rate}}", pb.rateText(),
+		"{{elapsed}}", formatDuration(time.Since(pb.startTime)),
+		"{{eta}}", pb.etaText(),
+	)
+	return strings.TrimSpace(replacer.Replace(pb.template))
+}
+
+// etaText estimates the remaining time from the average speed so far.
+func (pb *ProgressBar) etaText() string {
+	if pb.indeterminate() || pb.current <= 0 {
+		return "--"
+	}
+	if pb.current >= pb.total {
+		return formatDuration(0)
+	}
+	elapsed := time.Since(pb.startTime)
+	remaining := time.Duration(float64(elapsed) * float64(pb.total-pb.current) / float64(pb.current))
+	return formatDuration(remaining)
+}
+
+// formatPercent formats a percentage without decimals (e.g., "50%").
+func formatPercent(percent float64) string {
+	return fmt.Sprintf("%.0f%%", percent)
+}
@@ -0,0 +1,85 @@
+package colorbear
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestProgressGlyphsASCII(t *testing.T) {
+	ForceColors(false)
+	bar := NewProgress(100, WithWidth(10), WithGlyphs(ProgressGlyphsASCII))
+	bar.current = 50
+
+	if got := bar.barText(); got != "[=====>----]" {
+		t.Errorf("Expected '[=====>----]', got %q", got)
+	}
+
+	bar.current = 100
+	if got := bar.barText(); got != "[==========]" {
+		t.Errorf("Full bar should have no head, got %q", got)
+	}
+}
+
+func TestProgressGlyphsPartials(t *testing.T) {
+	ForceColors(false)
+	bar := NewProgress(80, WithWidth(10), WithGlyphs(ProgressGlyphsSmooth))
+
+	// 4.5 cells filled: 4 full blocks and a half block
+	bar.current = 36
+	if got := bar.barText(); got != "[████▌     ]" {
+		t.Errorf("Expected '[████▌     ]', got %q", got)
+	}
+}
+
+func TestProgressTemplate(t *testing.T) {
+	ForceColors(false)
+	bar := NewProgress(1000,
+		WithWidth(4),
+		WithPrefix("Work:"),
+		WithTemplate("{{prefix}} {{bar}} {{percent}} | {{count}} | {{eta}}"),
+	)
+	bar.current = 500
+
+	output := bar.render()
+	if !strings.HasPrefix(output, "Work: [██░░] 50% | 500/1000 | ") {
+		t.Errorf("Unexpected template output %q", output)
+	}
+
+	// Empty placeholders at the edges leave no whitespace
+	bar2 := NewProgress(10, WithTemplate("{{prefix}} {{percent}}"))
+	if got := bar2.render(); got != "0%" {
+		t.Errorf("Expected '0%%', got %q", got)
+	}
+}
+
+func TestProgressGradient(t *testing.T) {
+	ForceColors(true)
+	defer ForceColors(false)
+
+	bar := NewProgress(10, WithWidth(10), WithGradient("#ff0000", "#0000ff"))
+	bar.current = 10
+
+	output := bar.barText()
+	if !strings.Contains(output, "\033[38;2;255;0;0m") {
+		t.Error("Gradient should start with the start color")
+	}
+	if !strings.Contains(output, "\033[38;2;0;0;255m") {
+		t.Error("Gradient should end with the end color")
+	}
+
+	// Invalid colors fall back to the regular color
+	bar2 := NewProgress(10, WithGradient("red", "#0000ff"))
+	if bar2.gradient != nil {
+		t.Error("Invalid gradient colors should be ignored")
+	}
+}
+
+func TestParseHexColor(t *testing.T) {
+	c, ok := parseHexColor("#1a2b3c")
+	if !ok || c != (rgb{0x1a, 0x2b, 0x3c}) {
+		t.Errorf("Expected {26 43 60}, got %v (ok=%v)", c, ok)
+	}
+	if _, ok := parseHexColor("#12345"); ok {
+		t.Error("Short hex string should be rejected")
+	}
+}
@@ -1,6 +1,10 @@
 package colorbear
 
 import (
+	"bytes"
+	"io"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 )
@@ -131,3 +135,173 @@ func TestFormatDuration(t *testing.T) {
 		}
 	}
 }
+
+func TestBytesProgress(t *testing.T) {
+	bar := NewBytesProgress(5 << 30) // 5 GiB, beyond int32 range
+
+	if !bar.bytes {
+		t.Error("Expected bytes mode to be enabled")
+	}
+	if !bar.showCount || !bar.showRate {
+		t.Error("Expected count and rate to be shown by default")
+	}
+
+	bar.current = 3 << 30
+	if got := bar.countText(); got != "3.0 GiB/5.0 GiB" {
+		t.Errorf("Expected '3.0 GiB/5.0 GiB', got %q", got)
+	}
+}
+
+func TestProgressReaderWriter(t *testing.T) {
+	ForceColors(false)
+	data := strings.Repeat("x", 4096)
+
+	bar := NewBytesProgress(int64(len(data)))
+	if _, err := io.Copy(io.Discard, bar.Reader(strings.NewReader(data))); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if bar.current != int64(len(data)) {
+		t.Errorf("Reader: expected current %d, got %d", len(data), bar.current)
+	}
+
+	bar2 := NewBytesProgress(int64(len(data)))
+	if _, err := io.Copy(bar2.Writer(io.Discard), strings.NewReader(data)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if bar2.current != int64(len(data)) {
+		t.Errorf("Writer: expected current %d, got %d", len(data), bar2.current)
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		bytes    int64
+		expected string
+	}{
+		{0, "0 B"},
+		{512, "512 B"},
+		{1536, "1.5 KiB"},
+		{10 << 20, "10.0 MiB"},
+		{3 << 30, "3.0 GiB"},
+	}
+
+	for _, tt := range tests {
+		if got := formatBytes(tt.bytes); got != tt.expected {
+			t.Errorf("formatBytes(%d) = %q, expected %q", tt.bytes, got, tt.expected)
+		}
+	}
+}
+
+func TestProgressIndeterminate(t *testing.T) {
+	ForceColors(true)
+	defer ForceColors(false)
+
+	var buf bytes.Buffer
+	bar := NewProgress(0, WithProgressWriter(&buf), WithCount(true))
+
+	bar.Add(5)
+	if bar.current != 5 {
+		t.Errorf("Indeterminate bar should not cap progress, got %d", bar.current)
+	}
+
+	output := buf.String()
+	if strings.Contains(output, "NaN") || strings.Contains(output, "%") {
+		t.Errorf("Indeterminate bar should not show a percentage, got %q", output)
+	}
+	if !strings.Contains(output, "(5)") {
+		t.Errorf("Expected count '(5)', got %q", output)
+	}
+
+	// Marquee moves with each tick
+	first := bar.marquee()
+	bar.Tick()
+	if bar.marquee() == first {
+		t.Error("Marquee should move after Tick()")
+	}
+}
+
+func TestProgressMarqueeBounds(t *testing.T) {
+	ForceColors(false)
+	bar := NewProgress(-1, WithWidth(10))
+
+	for i := 0; i < 50; i++ {
+		bar.tick = i
+		if got := len([]rune(bar.marquee())); got != 10 {
+			t.Fatalf("tick %d: marquee width should be 10, got %d", i, got)
+		}
+	}
+}
+
+func TestProgressSetTotal(t *testing.T) {
+	ForceColors(false)
+	var buf bytes.Buffer
+	bar := NewProgress(0, WithProgressWriter(&buf))
+
+	bar.Set(25)
+	bar.SetTotal(100)
+
+	if bar.indeterminate() {
+		t.Error("Bar should be determinate after SetTotal(100)")
+	}
+	if got := bar.percent(); got != 25 {
+		t.Errorf("Expected 25%%, got %.0f%%", got)
+	}
+
+	bar.Set(150)
+	if got := bar.percent(); got != 100 {
+		t.Errorf("Percentage should be clamped to 100, got %.0f", got)
+	}
+}
+
+func TestProgressLogMode(t *testing.T) {
+	ForceColors(false)
+	var buf bytes.Buffer
+	bar := NewProgress(100, WithProgressWriter(&buf), WithLogStep(25))
+
+	if !bar.useLogMode() {
+		t.Fatal("Log mode should be chosen automatically for non-terminal writers")
+	}
+
+	for i := int64(0); i <= 100; i++ {
+		bar.Set(i)
+	}
+	bar.Finish("")
+
+	output := buf.String()
+	if strings.Contains(output, "\r") {
+		t.Error("Log mode output should not contain carriage returns")
+	}
+
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if len(lines) != 5 { // 0%, 25%, 50%, 75%, 100%
+		t.Fatalf("Expected 5 log lines, got %d: %q", len(lines), lines)
+	}
+
+	for i, line := range lines {
+		filled := i * 10 // Bar of 40 characters in steps of 25%
+		expected := "[" + strings.Repeat("█", filled) + strings.Repeat("░", 40-filled) + "] " +
+			strconv.Itoa(i*25) + "%"
+		if line != expected {
+			t.Errorf("Expected log line %q, got %q", expected, line)
+		}
+	}
+}
+
+func TestProgressLogInterval(t *testing.T) {
+	ForceColors(false)
+	var buf bytes.Buffer
+	bar := NewProgress(0,
+		WithProgressWriter(&buf),
+		WithLogMode(true),
+		WithLogInterval(time.Hour),
+	)
+
+	bar.Add(1)
+	bar.Add(1)
+	bar.lastLog = time.Now().Add(-2 * time.Hour)
+	bar.Add(1)
+
+	if lines := strings.Count(buf.String(), "\n"); lines != 2 {
+		t.Errorf("Expected 2 log lines (first draw and interval), got %d", lines)
+	}
+}
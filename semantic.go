@@ -13,6 +13,33 @@ import "fmt"
 // CONSOLE VERSIONS - Use Unicode symbols (for normal printing)
 // ============================================================================
 
+// messageKind describes the symbol and colors of a semantic message.
+//
+// It is shared by the console functions below and by components that
+// print semantic messages to their own writer (e.g. Spinner).
+type messageKind struct {
+	symbol string   // Leading symbol (e.g., "✓")
+	codes  []string // ANSI codes applied to the whole message
+}
+
+// Semantic message kinds used by the console functions
+var (
+	successKind = messageKind{"✓", []string{GreenCode}}
+	errorKind   = messageKind{"✗", []string{RedCode, Bold}}
+	warningKind = messageKind{"⚠", []string{YellowCode}}
+	infoKind    = messageKind{"ℹ", []string{CyanCode}}
+	debugKind   = messageKind{"🐛", []string{BrightBlack}}
+	skippedKind = messageKind{"↷", []string{BrightBlack}}
+)
+
+// format returns text with the kind's symbol, colored only if colored is true.
+func (k messageKind) format(text string, colored bool) string {
+	if text == "" {
+		return colorizeIf(colored, k.symbol, k.codes...)
+	}
+	return colorizeIf(colored, k.symbol+" "+text, k.codes...)
+}
+
 // Success returns a success message in green with a checkmark.
 //
 // Example:
@@ -20,10 +47,7 @@ import "fmt"
 //	colorbear.SuccessPrint("Deployment completed")
 //	// Output: ✓ Deployment completed (in green)
 func Success(text string) string {
-	if text == "" {
-		return colorize("✓", GreenCode)
-	}
-	return colorize("✓ "+text, GreenCode)
+	return successKind.format(text, isColorEnabled())
 }
 
 // SuccessPrint prints a success message
@@ -38,10 +62,7 @@ func Successf(format string, args ...interface{}) string {
 
 // Error returns an error message in red with X mark
 func Error(text string) string {
-	if text == "" {
-		return colorize("✗", RedCode, Bold)
-	}
-	return colorize("✗ "+text, RedCode, Bold)
+	return errorKind.format(text, isColorEnabled())
 }
 
 // ErrorPrint prints an error message
@@ -56,10 +77,7 @@ func Errorf(format string, args ...interface{}) string {
 
 // Warning returns a warning message in yellow
 func Warning(text string) string {
-	if text == "" {
-		return colorize("⚠", YellowCode)
-	}
-	return colorize("⚠ "+text, YellowCode)
+	return warningKind.format(text, isColorEnabled())
 }
 
 // WarningPrint prints a warning message
@@ -74,10 +92,7 @@ func Warningf(format string, args ...interface{}) string {
 
 // Info returns an info message in cyan
 func Info(text string) string {
-	if text == "" {
-		return colorize("ℹ", CyanCode)
-	}
-	return colorize("ℹ "+text, CyanCode)
+	return infoKind.format(text, isColorEnabled())
 }
 
 // InfoPrint prints an info message
@@ -92,10 +107,7 @@ func Infof(format string, args ...interface{}) string {
 
 // Debug returns a debug message in gray
 func Debug(text string) string {
-	if text == "" {
-		return colorize("🐛", BrightBlack)
-	}
-	return colorize("🐛 "+text, BrightBlack)
+	return debugKind.format(text, isColorEnabled())
 }
 
 // DebugPrint prints a debug message
@@ -2,6 +2,8 @@
 package colorbear
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -23,13 +25,16 @@ type Spinner struct {
 	message    string        // Message to display next to the spinner
 	frames     []string      // Animation frames (characters to cycle through)
 	current    int           // Current frame index
-	stop       chan bool     // Channel to signal stop
+	stop       chan struct{} // Closed to signal the animation goroutine to stop
+	done       chan struct{} // Closed by the animation goroutine when it has exited
 	running    bool          // Whether the spinner is currently running
 	mu         sync.Mutex    // Mutex to prevent race conditions
 	color      string        // Color for the spinner frames
 	speed      time.Duration // Animation speed (time between frames)
 	writer     io.Writer     // Output writer (default: os.Stdout)
 	lastOutput string        // Last output for efficient clearing
+	elapsed    bool          // Whether to show elapsed time next to the message
+	startTime  time.Time     // When the spinner was (last) started
 }
 
 // SpinnerOption is a functional option for configuring a Spinner.
@@ -51,7 +56,10 @@ const (
 	SpinnerPulse                        // ● ○ ● ○ (pulsing dot - smooth!)
 )
 
-// getSpinnerFrames returns the animation frames for a given style
+// getSpinnerFrames returns the animation frames for a given style.
+//
+// More spinners are available by name, see SpinnerNames() and
+// WithSpinnerName().
 func getSpinnerFrames(style SpinnerStyle) []string {
 	switch style {
 	case SpinnerDots:
@@ -102,7 +110,6 @@ func NewSpinner(message string, opts ...SpinnerOption) *Spinner {
 		message: message,
 		frames:  getSpinnerFrames(SpinnerDots),
 		current: 0,
-		stop:    make(chan bool, 1), // Buffered to prevent blocking
 		running: false,
 		color:   CyanCode,
 		speed:   60 * time.Millisecond, // Faster for smoother animation (16.6 FPS)
@@ -114,6 +121,11 @@ func NewSpinner(message string, opts ...SpinnerOption) *Spinner {
 		opt(s)
 	}
 
+	if len(s.frames) == 0 {
+		s.frames = getSpinnerFrames(SpinnerDots)
+	}
+	s.frames = normalizeFrames(s.frames)
+
 	return s
 }
 
@@ -171,6 +183,42 @@ func WithSpinnerSpeed(speed time.Duration) SpinnerOption {
 	}
 }
 
+// WithSpinnerWriter sets the output writer for the spinner.
+//
+// All spinner output goes to this writer: animation frames, cursor
+// control sequences and the final success or error message. Colors
+// and cursor control are only used if the writer is a terminal.
+// The default writer is os.Stdout.
+//
+// Example:
+//
+//	spinner := colorbear.NewSpinner("Loading...",
+//	    colorbear.WithSpinnerWriter(os.Stderr),
+//	)
+func WithSpinnerWriter(w io.Writer) SpinnerOption {
+	return func(s *Spinner) {
+		s.writer = w
+	}
+}
+
+// WithSpinnerElapsed shows the elapsed time next to the message.
+//
+// The time is updated while the spinner animates and is also appended
+// to the final message, formatted like progress bar times (e.g., "2.3s").
+//
+// Example:
+//
+//	spinner := colorbear.NewSpinner("Building...",
+//	    colorbear.WithSpinnerElapsed(true),
+//	)
+//	// Output while running: ⠋ Building... (1.2s)
+//	// Output when stopped:  ✓ Build complete (4.8s)
+func WithSpinnerElapsed(show bool) SpinnerOption {
+	return func(s *Spinner) {
+		s.elapsed = show
+	}
+}
+
 // WithCustomFrames sets custom animation frames.
 //
 // Provide your own sequence of characters/strings for animation.
@@ -190,7 +238,8 @@ func WithCustomFrames(frames []string) SpinnerOption {
 //
 // The spinner runs in a separate goroutine and animates until Stop()
 // or StopWithError() is called. It's safe to call Start() multiple times
-// (subsequent calls are ignored if already running).
+// (subsequent calls are ignored if already running), and a stopped
+// spinner can be started again.
 //
 // The animation is optimized for smooth, flicker-free rendering.
 //
@@ -204,36 +253,131 @@ func WithCustomFrames(frames []string) SpinnerOption {
 //
 //	spinner.Stop("Done!")
 func (s *Spinner) Start() {
+	s.StartContext(context.Background())
+}
+
+// StartContext begins the spinner animation bound to a context.
+//
+// The spinner behaves like Start(), but stops automatically when ctx is
+// cancelled or its deadline expires, printing the spinner message with
+// "(cancelled)" or "(timed out)" as an error. Calling Stop() or
+// StopWithError() before that works as usual.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+//	defer cancel()
+//
+//	spinner := colorbear.NewSpinner("Waiting for server...")
+//	spinner.StartContext(ctx)
+//	err := waitForServer(ctx)
+//	if err == nil {
+//	    spinner.Stop("Server is up!")
+//	}
+//	// Output on timeout: ✗ Waiting for server... (timed out)
+func (s *Spinner) StartContext(ctx context.Context) {
 	s.mu.Lock()
 	if s.running {
 		s.mu.Unlock()
 		return // Already running
 	}
 	s.running = true
+	s.startTime = time.Now()
+	s.stop = make(chan struct{})
+	s.done = make(chan struct{})
+	stop, done := s.stop, s.done
+	register(s) // Under s.mu, so it pairs with the unregister that stops this run
 	s.mu.Unlock()
 
-	go func() {
-		ticker := time.NewTicker(s.speed)
-		defer ticker.Stop()
+	go s.run(ctx, stop, done)
+}
 
-		// Hide cursor for smoother animation
-		if isColorEnabled() {
-			fmt.Fprint(s.writer, "\033[?25l") // Hide cursor
-		}
+// run is the animation loop executed by the spinner goroutine.
+//
+// It exits when stop is closed (Stop/StopWithError) or ctx is done, and
+// always closes done on exit so stopping never has to guess whether the
+// goroutine has finished.
+func (s *Spinner) run(ctx context.Context, stop, done chan struct{}) {
+	defer close(done)
 
-		for {
-			select {
-			case <-s.stop:
-				// Show cursor again
-				if isColorEnabled() {
-					fmt.Fprint(s.writer, "\033[?25h") // Show cursor
-				}
-				return
-			case <-ticker.C:
-				s.render()
-			}
+	ticker := time.NewTicker(s.speed)
+	defer ticker.Stop()
+
+	// Hide cursor for smoother animation
+	if s.colored() {
+		fmt.Fprint(s.writer, hideCursor)
+	}
+	defer func() {
+		// Show cursor again
+		if s.colored() {
+			fmt.Fprint(s.writer, showCursor)
 		}
 	}()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ctx.Done():
+			s.cancel(ctx.Err(), stop)
+			return
+		case <-ticker.C:
+			s.render()
+		}
+	}
+}
+
+// cancel stops the spinner after its context ended.
+//
+// If a Stop() call is already in progress, cancel just waits for its stop
+// signal so that only one final message is printed.
+func (s *Spinner) cancel(err error, stop chan struct{}) {
+	s.mu.Lock()
+	if !s.running {
+		s.mu.Unlock()
+		<-stop
+		return
+	}
+	s.running = false
+	unregister(s)
+	s.mu.Unlock()
+
+	reason := "cancelled"
+	if errors.Is(err, context.DeadlineExceeded) {
+		reason = "timed out"
+	}
+
+	s.clearLine()
+	s.writeLine(s.finalLine(errorKind, s.message+" ("+reason+")"))
+}
+
+// Spin runs fn while showing a spinner with the given message.
+//
+// The spinner is bound to ctx (see StartContext) and stops with a success
+// message when fn returns nil, or with an error message containing the
+// returned error otherwise. The error from fn is returned unchanged.
+// A trailing "..." is removed from the message for the final line.
+//
+// Example:
+//
+//	err := colorbear.Spin(ctx, "Deploying...", func(ctx context.Context) error {
+//	    return deploy(ctx)
+//	})
+//	// Output: ✓ Deploying
+//	// or:     ✗ Deploying: connection refused
+func Spin(ctx context.Context, message string, fn func(ctx context.Context) error, opts ...SpinnerOption) error {
+	s := NewSpinner(message, opts...)
+	s.StartContext(ctx)
+
+	err := fn(ctx)
+
+	final := strings.TrimSuffix(message, "...")
+	if err != nil {
+		s.StopWithError(final + ": " + err.Error())
+	} else {
+		s.Stop(final)
+	}
+	return err
 }
 
 // render draws the current spinner frame (internal method)
@@ -244,17 +388,11 @@ func (s *Spinner) render() {
 	frame := s.frames[s.current%len(s.frames)]
 	s.current++
 
-	var output string
-	if isColorEnabled() {
-		output = fmt.Sprintf("\r%s %s", colorize(frame, s.color), s.message)
-	} else {
-		output = fmt.Sprintf("\r%s %s", frame, s.message)
-	}
+	output := fmt.Sprintf("\r%s %s%s", colorizeIf(s.colored(), frame, s.color), s.message, s.elapsedText())
 
 	// Clear any leftover characters from previous render
-	if len(s.lastOutput) > len(output) {
-		padding := strings.Repeat(" ", len(s.lastOutput)-len(output))
-		output += padding
+	if width, last := visualWidth(output[1:]), s.lastWidth(); last > width {
+		output += strings.Repeat(" ", last-width)
 	}
 
 	fmt.Fprint(s.writer, output)
@@ -282,34 +420,7 @@ func (s *Spinner) render() {
 //
 //	spinner.Stop("Download complete!")
 func (s *Spinner) Stop(message string) {
-	s.mu.Lock()
-	if !s.running {
-		s.mu.Unlock()
-		return // Not running
-	}
-	s.running = false
-	s.mu.Unlock()
-
-	// Signal stop
-	select {
-	case s.stop <- true:
-	default:
-		// Channel already has a value or is closed
-	}
-
-	// Small delay to ensure goroutine has stopped
-	time.Sleep(10 * time.Millisecond)
-
-	// Clear the spinner line completely
-	s.mu.Lock()
-	clearLength := maxInt(len(s.lastOutput), len(s.message)+10)
-	s.mu.Unlock()
-
-	fmt.Fprint(s.writer, "\r"+strings.Repeat(" ", clearLength)+"\r")
-
-	if message != "" {
-		SuccessPrint(message)
-	}
+	s.stopWith(successKind, message)
 }
 
 // StopWithError stops the spinner and displays an error message.
@@ -332,31 +443,200 @@ func (s *Spinner) Stop(message string) {
 //
 //	spinner.Stop("Connected!")
 func (s *Spinner) StopWithError(message string) {
+	if !s.halt() {
+		return // Not running
+	}
+
+	s.writeLine(s.finalLine(errorKind, message))
+}
+
+// StopWithWarning stops the spinner and displays a warning message.
+//
+// Use this when an operation completed, but with problems worth noting
+// (yellow with warning sign). An empty message prints nothing.
+//
+// Example:
+//
+//	spinner.StopWithWarning("Synced with 3 conflicts")
+//	// Output: ⚠ Synced with 3 conflicts
+func (s *Spinner) StopWithWarning(message string) {
+	s.stopWith(warningKind, message)
+}
+
+// StopWithInfo stops the spinner and displays an informational message
+// (cyan with info sign). An empty message prints nothing.
+//
+// Example:
+//
+//	spinner.StopWithInfo("Already up to date")
+//	// Output: ℹ Already up to date
+func (s *Spinner) StopWithInfo(message string) {
+	s.stopWith(infoKind, message)
+}
+
+// StopWithSkipped stops the spinner and marks the operation as skipped
+// (gray with skip arrow). An empty message prints nothing.
+//
+// Example:
+//
+//	spinner.StopWithSkipped("Tests skipped (--fast)")
+//	// Output: ↷ Tests skipped (--fast)
+func (s *Spinner) StopWithSkipped(message string) {
+	s.stopWith(skippedKind, message)
+}
+
+// Persist stops the spinner and keeps its last frame and message on screen.
+//
+// Use this when the spinner line itself is the best final status,
+// e.g. when the message was updated with results along the way.
+//
+// Example:
+//
+//	spinner.UpdateMessage("Indexed 1,204 files")
+//	spinner.Persist()
+//	// Output: ⠹ Indexed 1,204 files
+func (s *Spinner) Persist() {
+	if !s.halt() {
+		return // Not running
+	}
+
+	// The animation has stopped, so this is the last frame that was shown
+	s.mu.Lock()
+	frame := s.frames[maxInt(0, s.current-1)%len(s.frames)]
+	line := fmt.Sprintf("%s %s%s", colorizeIf(s.colored(), frame, s.color), s.message, s.elapsedText())
+	s.mu.Unlock()
+
+	s.writeLine(line)
+}
+
+// stopWith stops the spinner and prints a non-empty message of the given kind.
+func (s *Spinner) stopWith(kind messageKind, message string) {
+	if !s.halt() {
+		return // Not running
+	}
+
+	if message != "" {
+		s.writeLine(s.finalLine(kind, message))
+	}
+}
+
+// finalLine formats a final message, adding the elapsed time if enabled.
+func (s *Spinner) finalLine(kind messageKind, message string) string {
+	return kind.format(message, s.colored()) + s.elapsedText()
+}
+
+// elapsedText returns " (elapsed)" if elapsed time display is enabled.
+func (s *Spinner) elapsedText() string {
+	if !s.elapsed {
+		return ""
+	}
+	return " " + colorizeIf(s.colored(), "("+formatDuration(time.Since(s.startTime))+")", BrightBlack)
+}
+
+// halt stops the animation goroutine and clears the spinner line.
+//
+// It returns false if the spinner was not running. Otherwise it waits
+// until the goroutine has exited, so no frame can be drawn after the
+// line has been cleared.
+func (s *Spinner) halt() bool {
 	s.mu.Lock()
 	if !s.running {
 		s.mu.Unlock()
-		return // Not running
+		return false
 	}
 	s.running = false
+	unregister(s)
+	stop, done := s.stop, s.done
 	s.mu.Unlock()
 
-	// Signal stop
-	select {
-	case s.stop <- true:
-	default:
-	}
+	close(stop)
+	<-done
 
-	// Small delay to ensure goroutine has stopped
-	time.Sleep(10 * time.Millisecond)
+	s.clearLine()
+	return true
+}
+
+// colored reports whether colors and cursor control are enabled for the
+// spinner's writer.
+func (s *Spinner) colored() bool {
+	return isColorEnabledFor(s.writer)
+}
+
+// writeLine writes a final message line to the spinner's writer.
+func (s *Spinner) writeLine(line string) {
+	fmt.Fprintln(s.writer, line)
+}
 
-	// Clear the spinner line
+// restore stops the spinner without a final message (see Restore).
+func (s *Spinner) restore() {
+	s.halt()
+}
+
+// lastWidth returns the width of the last drawn line on screen. The caller
+// must hold s.mu.
+func (s *Spinner) lastWidth() int {
+	return visualWidth(strings.TrimPrefix(s.lastOutput, "\r"))
+}
+
+// clearLine erases the spinner line completely.
+func (s *Spinner) clearLine() {
 	s.mu.Lock()
-	clearLength := maxInt(len(s.lastOutput), len(s.message)+10)
+	clearLength := maxInt(s.lastWidth(), visualWidth(s.message)+10)
+	s.lastOutput = ""
 	s.mu.Unlock()
 
 	fmt.Fprint(s.writer, "\r"+strings.Repeat(" ", clearLength)+"\r")
+}
+
+// Println prints a line above the spinner while it keeps animating.
+//
+// The spinner line is cleared, the message is printed (formatted like
+// fmt.Println) and the spinner is redrawn below it, all in one step so
+// that concurrent frames never interleave with the output. If the spinner
+// is not running, the line is simply printed.
+//
+// Example:
+//
+//	spinner := colorbear.NewSpinner("Installing packages...")
+//	spinner.Start()
+//	for _, pkg := range packages {
+//	    install(pkg)
+//	    spinner.Println("fetched", pkg)
+//	}
+//	spinner.Stop("All packages installed")
+func (s *Spinner) Println(args ...interface{}) {
+	s.printAbove(fmt.Sprintln(args...))
+}
+
+// Printf prints a formatted line above the spinner while it keeps animating.
+//
+// It works like Println but formats according to a format specifier.
+// A trailing newline is added if the format doesn't end with one.
+//
+// Example:
+//
+//	spinner.Printf("fetched %s (%d KiB)", pkg.Name, pkg.Size/1024)
+func (s *Spinner) Printf(format string, args ...interface{}) {
+	text := fmt.Sprintf(format, args...)
+	if !strings.HasSuffix(text, "\n") {
+		text += "\n"
+	}
+	s.printAbove(text)
+}
+
+// printAbove clears the spinner line, writes text and redraws the last
+// frame. Holding s.mu keeps render() from drawing in between.
+func (s *Spinner) printAbove(text string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.running || s.lastOutput == "" {
+		fmt.Fprint(s.writer, text)
+		return
+	}
 
-	ErrorPrint(message)
+	erase := "\r" + strings.Repeat(" ", s.lastWidth()) + "\r"
+	fmt.Fprint(s.writer, erase+text+s.lastOutput)
 }
 
 // UpdateMessage updates the spinner message while it's running.
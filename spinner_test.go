@@ -1,6 +1,10 @@
 package colorbear
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 )
@@ -106,3 +110,298 @@ func TestSpinnerCustomFrames(t *testing.T) {
 		t.Errorf("Expected first frame 'A', got %q", spinner.frames[0])
 	}
 }
+
+func TestSpinnerStopWaitsForGoroutine(t *testing.T) {
+	ForceColors(false)
+
+	spinner := NewSpinner("Testing...")
+	spinner.Start()
+	done := spinner.done
+	spinner.Stop("")
+
+	select {
+	case <-done:
+	default:
+		t.Error("Stop() should return only after the animation goroutine exited")
+	}
+}
+
+func TestSpinnerStartContextCancel(t *testing.T) {
+	ForceColors(false)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	spinner := NewSpinner("Waiting...")
+	spinner.StartContext(ctx)
+	done := spinner.done
+
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Spinner should stop when its context is cancelled")
+	}
+
+	spinner.mu.Lock()
+	running := spinner.running
+	spinner.mu.Unlock()
+	if running {
+		t.Error("Spinner should not be running after cancellation")
+	}
+
+	// Stopping after cancellation is a no-op
+	spinner.Stop("ignored")
+}
+
+// gateWriter blocks writes containing text until gate is closed.
+type gateWriter struct {
+	text string
+	gate chan struct{}
+}
+
+func (w *gateWriter) Write(p []byte) (int, error) {
+	if strings.Contains(string(p), w.text) {
+		<-w.gate
+	}
+	return len(p), nil
+}
+
+func TestSpinnerRestartAfterCancelStaysRegistered(t *testing.T) {
+	ForceColors(false)
+
+	writer := &gateWriter{text: "cancelled", gate: make(chan struct{})}
+	spinner := NewSpinner("Waiting...", WithSpinnerWriter(writer))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	spinner.StartContext(ctx)
+	done := spinner.done
+	cancel()
+
+	// Restart while the cancelled animation goroutine is still writing
+	// its final line
+	for {
+		spinner.mu.Lock()
+		running := spinner.running
+		spinner.mu.Unlock()
+		if !running {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	spinner.Start()
+	close(writer.gate)
+	<-done
+
+	activeMu.Lock()
+	_, registered := active[spinner]
+	activeMu.Unlock()
+	spinner.Stop("")
+
+	if !registered {
+		t.Error("A restarted spinner should stay in the cleanup registry")
+	}
+}
+
+func TestSpin(t *testing.T) {
+	ForceColors(false)
+
+	err := Spin(context.Background(), "Working...", func(ctx context.Context) error {
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Expected nil error, got %v", err)
+	}
+
+	failure := errors.New("boom")
+	err = Spin(context.Background(), "Working...", func(ctx context.Context) error {
+		return failure
+	})
+	if !errors.Is(err, failure) {
+		t.Errorf("Spin should return the error from fn, got %v", err)
+	}
+}
+
+func TestSpinnerRestart(t *testing.T) {
+	ForceColors(false)
+
+	spinner := NewSpinner("Testing...", WithSpinnerSpeed(10*time.Millisecond))
+	for i := 0; i < 3; i++ {
+		spinner.Start()
+		time.Sleep(30 * time.Millisecond)
+		spinner.Stop("")
+
+		if spinner.running {
+			t.Fatalf("Run %d: spinner should not be running after Stop()", i)
+		}
+	}
+}
+
+func TestSpinnerWriter(t *testing.T) {
+	mu.Lock()
+	forceColors = nil // Auto-detect based on the writer
+	mu.Unlock()
+	defer ForceColors(false)
+
+	var buf bytes.Buffer
+	spinner := NewSpinner("Working...",
+		WithSpinnerWriter(&buf),
+		WithSpinnerSpeed(10*time.Millisecond),
+	)
+	spinner.Start()
+	time.Sleep(50 * time.Millisecond)
+	spinner.Stop("Finished")
+
+	spinner.Start()
+	spinner.StopWithError("Failed")
+
+	output := buf.String()
+	if !strings.Contains(output, "Working...") {
+		t.Error("Frames should be written to the spinner writer")
+	}
+	if !strings.Contains(output, "✓ Finished") || !strings.Contains(output, "✗ Failed") {
+		t.Errorf("Final messages should be written to the spinner writer, got %q", output)
+	}
+	if strings.Contains(output, "\x1b[") {
+		t.Error("No colors or cursor control should be written to a non-terminal writer")
+	}
+}
+
+func TestSpinnerTerminalStates(t *testing.T) {
+	ForceColors(false)
+
+	tests := []struct {
+		name     string
+		stop     func(s *Spinner)
+		expected string
+	}{
+		{"Warning", func(s *Spinner) { s.StopWithWarning("careful") }, "⚠ careful"},
+		{"Info", func(s *Spinner) { s.StopWithInfo("up to date") }, "ℹ up to date"},
+		{"Skipped", func(s *Spinner) { s.StopWithSkipped("not needed") }, "↷ not needed"},
+		{"Persist", func(s *Spinner) { s.Persist() }, "A Working..."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			spinner := NewSpinner("Working...",
+				WithSpinnerWriter(&buf),
+				WithCustomFrames([]string{"A"}),
+			)
+			spinner.Start()
+			tt.stop(spinner)
+
+			if !strings.HasSuffix(buf.String(), tt.expected+"\n") {
+				t.Errorf("Expected output to end with %q, got %q", tt.expected, buf.String())
+			}
+		})
+	}
+}
+
+func TestSpinnerElapsed(t *testing.T) {
+	ForceColors(false)
+
+	var buf bytes.Buffer
+	spinner := NewSpinner("Working...",
+		WithSpinnerWriter(&buf),
+		WithSpinnerElapsed(true),
+		WithSpinnerSpeed(10*time.Millisecond),
+	)
+	spinner.Start()
+	time.Sleep(30 * time.Millisecond)
+	spinner.Stop("Done")
+
+	output := buf.String()
+	if !strings.Contains(output, "Working... (") {
+		t.Errorf("Elapsed time should be shown while spinning, got %q", output)
+	}
+	if !strings.Contains(output, "✓ Done (") {
+		t.Errorf("Elapsed time should be shown in the final line, got %q", output)
+	}
+}
+
+func TestSpinnerPrintln(t *testing.T) {
+	ForceColors(false)
+
+	var buf bytes.Buffer
+	spinner := NewSpinner("Working...",
+		WithSpinnerWriter(&buf),
+		WithSpinnerSpeed(5*time.Millisecond),
+	)
+
+	// Not running: printed as is
+	spinner.Println("before")
+
+	spinner.Start()
+	time.Sleep(20 * time.Millisecond)
+	spinner.Println("fetched", "pkg-a")
+	spinner.Printf("fetched %s", "pkg-b")
+	spinner.Stop("")
+
+	output := buf.String()
+	if !strings.HasPrefix(output, "before\n") {
+		t.Errorf("Println without running spinner should print directly, got %q", output)
+	}
+	for _, line := range []string{"fetched pkg-a\n", "fetched pkg-b\n"} {
+		index := strings.Index(output, line)
+		if index < 0 {
+			t.Fatalf("Output should contain %q, got %q", line, output)
+		}
+		if !strings.HasPrefix(output[index+len(line):], "\r") {
+			t.Errorf("Spinner should be redrawn after %q", line)
+		}
+	}
+}
+
+func TestSpinnerPrintAboveErasesVisualWidth(t *testing.T) {
+	var buf bytes.Buffer
+	spinner := NewSpinner("Working…", WithSpinnerWriter(&buf))
+	spinner.running = true
+	spinner.lastOutput = "\r\033[36m⠋\033[0m Working…"
+
+	spinner.printAbove("done\n")
+
+	expected := "\r" + strings.Repeat(" ", 10) + "\r" + "done\n" + spinner.lastOutput
+	if buf.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, buf.String())
+	}
+}
+
+func TestSpinnerClearLineErasesVisualWidth(t *testing.T) {
+	var buf bytes.Buffer
+	spinner := NewSpinner("処理", WithSpinnerWriter(&buf))
+	spinner.lastOutput = "\r\033[36m⠋\033[0m 処理中です" + strings.Repeat(" ", 4)
+
+	spinner.clearLine()
+
+	// 1 for the frame, 1 space, 10 for five wide characters, 4 of padding
+	expected := "\r" + strings.Repeat(" ", 16) + "\r"
+	if buf.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, buf.String())
+	}
+}
+
+func TestSpinnerPersistKeepsLastFrame(t *testing.T) {
+	ForceColors(false)
+
+	for i := 0; i < 20; i++ {
+		var buf bytes.Buffer
+		spinner := NewSpinner("Working...",
+			WithSpinnerWriter(&buf),
+			WithCustomFrames([]string{"A", "B", "C"}),
+			WithSpinnerSpeed(time.Microsecond),
+		)
+		spinner.Start()
+		time.Sleep(time.Millisecond)
+		spinner.Persist()
+
+		// The persisted line follows the last drawn frame and the clearing
+		parts := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\r")
+		if len(parts) < 4 {
+			t.Fatalf("Expected frames before the persisted line, got %q", buf.String())
+		}
+		last, persisted := strings.TrimRight(parts[len(parts)-3], " "), parts[len(parts)-1]
+		if last != persisted {
+			t.Fatalf("Expected the persisted line %q to match the last frame %q", persisted, last)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+// spinners.go
+package colorbear
+
+import (
+	"sort"
+	"strings"
+)
+
+// spinnerRegistry maps spinner names to their animation frames.
+//
+// The names and frames follow the widely used cli-spinners collection,
+// so spinner names from other tools and config files work unchanged.
+// The predefined SpinnerStyle constants are available under their
+// lower camel case names ("dots", "line", "growDots", ...).
+var spinnerRegistry = map[string][]string{
+	// Built-in styles
+	"dots":     getSpinnerFrames(SpinnerDots),
+	"line":     getSpinnerFrames(SpinnerLine),
+	"arrow":    getSpinnerFrames(SpinnerArrow),
+	"bounce":   getSpinnerFrames(SpinnerBounce),
+	"circle":   getSpinnerFrames(SpinnerCircle),
+	"square":   getSpinnerFrames(SpinnerSquare),
+	"growDots": getSpinnerFrames(SpinnerGrowDots),
+	"pulse":    getSpinnerFrames(SpinnerPulse),
+
+	// Braille dots
+	"dots2":  {"⣾", "⣽", "⣻", "⢿", "⡿", "⣟", "⣯", "⣷"},
+	"dots3":  {"⠋", "⠙", "⠚", "⠞", "⠖", "⠦", "⠴", "⠲", "⠳", "⠓"},
+	"dots4":  {"⠄", "⠆", "⠇", "⠋", "⠙", "⠸", "⠰", "⠠", "⠰", "⠸", "⠙", "⠋", "⠇", "⠆"},
+	"dots5":  {"⠋", "⠙", "⠚", "⠒", "⠂", "⠂", "⠒", "⠲", "⠴", "⠦", "⠖", "⠒", "⠐", "⠐", "⠒", "⠓", "⠋"},
+	"dots6":  {"⠁", "⠉", "⠙", "⠚", "⠒", "⠂", "⠂", "⠒", "⠲", "⠴", "⠤", "⠄", "⠄", "⠤", "⠴", "⠲", "⠒", "⠂", "⠂", "⠒", "⠚", "⠙", "⠉", "⠁"},
+	"dots7":  {"⠈", "⠉", "⠋", "⠓", "⠒", "⠐", "⠐", "⠒", "⠖", "⠦", "⠤", "⠠", "⠠", "⠤", "⠦", "⠖", "⠒", "⠐", "⠐", "⠒", "⠓", "⠋", "⠉", "⠈"},
+	"dots8":  {"⠁", "⠁", "⠉", "⠙", "⠚", "⠒", "⠂", "⠂", "⠒", "⠲", "⠴", "⠤", "⠄", "⠄", "⠤", "⠠", "⠠", "⠤", "⠦", "⠖", "⠒", "⠐", "⠐", "⠒", "⠓", "⠋", "⠉", "⠈", "⠈"},
+	"dots9":  {"⢹", "⢺", "⢼", "⣸", "⣇", "⡧", "⡗", "⡏"},
+	"dots10": {"⢄", "⢂", "⢁", "⡁", "⡈", "⡐", "⡠"},
+	"dots11": {"⠁", "⠂", "⠄", "⡀", "⢀", "⠠", "⠐", "⠈"},
+	"dots12": {
+		"⢀⠀", "⡀⠀", "⠄⠀", "⢂⠀", "⡂⠀", "⠅⠀", "⢃⠀", "⡃⠀", "⠍⠀", "⢋⠀", "⡋⠀", "⠍⠁", "⢋⠁", "⡋⠁",
+		"⠍⠉", "⠋⠉", "⠋⠉", "⠉⠙", "⠉⠙", "⠉⠩", "⠈⢙", "⠈⡙", "⢈⠩", "⡀⢙", "⠄⡙", "⢂⠩", "⡂⢘", "⠅⡘",
+		"⢃⠨", "⡃⢐", "⠍⡐", "⢋⠠", "⡋⢀", "⠍⡁", "⢋⠁", "⡋⠁", "⠍⠉", "⠋⠉", "⠋⠉", "⠉⠙", "⠉⠙", "⠉⠩",
+		"⠈⢙", "⠈⡙", "⠈⠩", "⠀⢙", "⠀⡙", "⠀⠩", "⠀⢘", "⠀⡘", "⠀⠨", "⠀⢐", "⠀⡐", "⠀⠠", "⠀⢀", "⠀⡀",
+	},
+
+	// Lines and ASCII
+	"line2":               {"⠂", "-", "–", "—", "–", "-"},
+	"pipe":                {"┤", "┘", "┴", "└", "├", "┌", "┬", "┐"},
+	"simpleDots":          {".  ", ".. ", "...", "   "},
+	"simpleDotsScrolling": {".  ", ".. ", "...", " ..", "  .", "   "},
+	"star":                {"✶", "✸", "✹", "✺", "✹", "✷"},
+	"star2":               {"+", "x", "*"},
+	"flip":                {"_", "_", "_", "-", "`", "`", "'", "´", "-", "_", "_", "_"},
+	"hamburger":           {"☱", "☲", "☴"},
+	"layer":               {"-", "=", "≡"},
+	"bouncingBar": {
+		"[    ]", "[=   ]", "[==  ]", "[=== ]", "[ ===]", "[  ==]", "[   =]", "[    ]",
+		"[   =]", "[  ==]", "[ ===]", "[====]", "[=== ]", "[==  ]", "[=   ]",
+	},
+	"bouncingBall": {
+		"( ●    )", "(  ●   )", "(   ●  )", "(    ● )", "(     ●)",
+		"(    ● )", "(   ●  )", "(  ●   )", "( ●    )", "(●     )",
+	},
+
+	// Blocks and shapes
+	"growVertical":   {"▁", "▃", "▄", "▅", "▆", "▇", "▆", "▅", "▄", "▃"},
+	"growHorizontal": {"▏", "▎", "▍", "▌", "▋", "▊", "▉", "▊", "▋", "▌", "▍", "▎"},
+	"balloon":        {" ", ".", "o", "O", "@", "*", " "},
+	"balloon2":       {".", "o", "O", "°", "O", "o", "."},
+	"noise":          {"▓", "▒", "░"},
+	"boxBounce":      {"▖", "▘", "▝", "▗"},
+	"boxBounce2":     {"▌", "▀", "▐", "▄"},
+	"triangle":       {"◢", "◣", "◤", "◥"},
+	"arc":            {"◜", "◠", "◝", "◞", "◡", "◟"},
+	"circleHalves":   {"◐", "◓", "◑", "◒"},
+	"circleQuarters": {"◴", "◷", "◶", "◵"},
+	"squareCorners":  {"◰", "◳", "◲", "◱"},
+	"squish":         {"╫", "╪"},
+	"arrow3":         {"▹▹▹▹▹", "▸▹▹▹▹", "▹▸▹▹▹", "▹▹▸▹▹", "▹▹▹▸▹", "▹▹▹▹▸"},
+	"point":          {"∙∙∙", "●∙∙", "∙●∙", "∙∙●", "∙∙∙"},
+	"betaWave":       {"ρββββββ", "βρβββββ", "ββρββββ", "βββρβββ", "ββββρββ", "βββββρβ", "ββββββρ"},
+	"aesthetic":      {"▰▱▱▱▱▱▱", "▰▰▱▱▱▱▱", "▰▰▰▱▱▱▱", "▰▰▰▰▱▱▱", "▰▰▰▰▰▱▱", "▰▰▰▰▰▰▱", "▰▰▰▰▰▰▰", "▰▱▱▱▱▱▱"},
+
+	// Toggles
+	"toggle":   {"⊶", "⊷"},
+	"toggle2":  {"▫", "▪"},
+	"toggle3":  {"□", "■"},
+	"toggle4":  {"■", "□", "▪", "▫"},
+	"toggle5":  {"▮", "▯"},
+	"toggle7":  {"⦾", "⦿"},
+	"toggle8":  {"◍", "◌"},
+	"toggle9":  {"◉", "◎"},
+	"toggle10": {"㊂", "㊀", "㊁"},
+	"toggle11": {"⧇", "⧆"},
+	"toggle12": {"☗", "☖"},
+	"toggle13": {"=", "*", "-"},
+
+	// Emoji (two columns wide in most terminals)
+	"clock":     {"🕛", "🕐", "🕑", "🕒", "🕓", "🕔", "🕕", "🕖", "🕗", "🕘", "🕙", "🕚"},
+	"earth":     {"🌍", "🌎", "🌏"},
+	"moon":      {"🌑", "🌒", "🌓", "🌔", "🌕", "🌖", "🌗", "🌘"},
+	"smiley":    {"😄", "😝"},
+	"monkey":    {"🙈", "🙈", "🙉", "🙊"},
+	"runner":    {"🚶", "🏃"},
+	"christmas": {"🌲", "🎄"},
+}
+
+// SpinnerNames returns the names of all registered spinners, sorted
+// alphabetically.
+//
+// Example:
+//
+//	for _, name := range colorbear.SpinnerNames() {
+//	    fmt.Println(name)
+//	}
+func SpinnerNames() []string {
+	names := make([]string, 0, len(spinnerRegistry))
+	for name := range spinnerRegistry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SpinnerFrames returns the animation frames of a registered spinner.
+//
+// The second return value is false if no spinner with that name exists.
+// The returned slice is a copy and may be modified freely.
+//
+// Example:
+//
+//	frames, ok := colorbear.SpinnerFrames(cfg.Spinner)
+//	if !ok {
+//	    frames, _ = colorbear.SpinnerFrames("dots")
+//	}
+func SpinnerFrames(name string) ([]string, bool) {
+	frames, ok := spinnerRegistry[name]
+	if !ok {
+		return nil, false
+	}
+	return append([]string(nil), frames...), true
+}
+
+// WithSpinnerName sets the animation frames by spinner name.
+//
+// This is useful when the spinner is chosen in a config file or on the
+// command line. Unknown names are ignored and the default style is kept.
+// See SpinnerNames() for all available names.
+//
+// Example:
+//
+//	spinner := colorbear.NewSpinner("Loading...",
+//	    colorbear.WithSpinnerName("moon"),
+//	)
+func WithSpinnerName(name string) SpinnerOption {
+	return func(s *Spinner) {
+		if frames, ok := SpinnerFrames(name); ok {
+			s.frames = frames
+		}
+	}
+}
+
+// normalizeFrames pads all frames with trailing spaces to the same
+// visual width.
+//
+// Without padding, frames of different widths (e.g., emoji next to
+// ASCII, or spinners with a trailing blank frame) make the message
+// after the spinner jump left and right.
+func normalizeFrames(frames []string) []string {
+	width := 0
+	for _, frame := range frames {
+		width = maxInt(width, visualWidth(frame))
+	}
+
+	normalized := make([]string, len(frames))
+	for i, frame := range frames {
+		normalized[i] = frame + strings.Repeat(" ", width-visualWidth(frame))
+	}
+	return normalized
+}
@@ -0,0 +1,69 @@
+package colorbear
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestSpinnerRegistry(t *testing.T) {
+	names := SpinnerNames()
+	if !sort.StringsAreSorted(names) {
+		t.Error("SpinnerNames() should be sorted")
+	}
+
+	for _, name := range []string{"dots", "dots2", "dots12", "line2", "star", "toggle", "clock", "moon", "earth", "aesthetic"} {
+		frames, ok := SpinnerFrames(name)
+		if !ok || len(frames) == 0 {
+			t.Errorf("Spinner %q should be registered", name)
+		}
+	}
+
+	for _, name := range names {
+		frames, _ := SpinnerFrames(name)
+		for i, frame := range frames {
+			if frame == "" {
+				t.Errorf("Spinner %q frame %d is empty", name, i)
+			}
+		}
+	}
+
+	if _, ok := SpinnerFrames("does-not-exist"); ok {
+		t.Error("Unknown spinner names should not be found")
+	}
+}
+
+func TestSpinnerFramesCopy(t *testing.T) {
+	frames, _ := SpinnerFrames("dots")
+	frames[0] = "X"
+
+	again, _ := SpinnerFrames("dots")
+	if again[0] == "X" {
+		t.Error("SpinnerFrames() should return a copy")
+	}
+}
+
+func TestWithSpinnerName(t *testing.T) {
+	spinner := NewSpinner("Test", WithSpinnerName("moon"))
+	if len(spinner.frames) != 8 {
+		t.Errorf("Expected 8 moon frames, got %d", len(spinner.frames))
+	}
+
+	// Unknown names keep the default style
+	spinner = NewSpinner("Test", WithSpinnerName("nope"))
+	if len(spinner.frames) != 10 {
+		t.Errorf("Expected default dots frames, got %d", len(spinner.frames))
+	}
+}
+
+func TestNormalizeFrames(t *testing.T) {
+	frames := normalizeFrames([]string{"🕐", "a", "..."})
+
+	for _, frame := range frames {
+		if width := visualWidth(frame); width != 3 {
+			t.Errorf("Frame %q should have width 3, got %d", frame, width)
+		}
+	}
+	if frames[1] != "a  " {
+		t.Errorf("Expected 'a  ', got %q", frames[1])
+	}
+}
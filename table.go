@@ -2,6 +2,7 @@ package colorbear
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"strings"
 )
@@ -28,9 +29,17 @@ import (
 //	)
 type Table struct {
 	headers      []string
-	rows         [][]string
+	rows         []tableRow
 	footer       []string
+	summary      []Aggregate // Computed footer (see SetSummary)
+	title        string
+	caption      string
 	columnWidths []int
+	columns      []Column // Per-column configuration (see SetColumns)
+	hidden       []bool   // Columns hidden to fit the table width
+	rules        []formatRule
+	writer       io.Writer // Output of a streaming table (see Stream)
+	firstRow     int       // Index of rows[0] in the whole table, for row colors (see Pages)
 	style        *TableStyle
 	options      *TableOptions
 }
@@ -51,7 +60,23 @@ type TableOptions struct {
 	MinWidth     int         // Minimum width for all columns
 	MaxWidth     int         // Maximum width for all columns
 	ColumnWidths []int       // Fixed column widths (overrides auto-sizing)
-	Style        *TableStyle // Table style (used internally)
+	Overflow     []Overflow  // Wrap or truncate over-long text per column
+
+	MaxTableWidth    int   // Maximum total table width (0: unlimited)
+	FitToTerminal    bool  // Limit the table width to the terminal width
+	ColumnMinWidths  []int // Minimum width per column when shrinking to fit
+	ColumnPriorities []int // Priority per column (higher is shrunk and hidden last)
+	HideColumns      bool  // Hide low-priority columns that do not fit
+	SampleRows       int   // Rows buffered to compute widths when streaming
+	AutoMerge        bool  // Merge vertically adjacent equal cells
+	MergeColumns     []int // Columns to merge (all if empty)
+
+	TitlePosition TitlePosition // Title inside the top border or above the table
+	TitleAlign    Alignment     // Alignment of the title
+	TitleColor    string        // Color for the title
+	Vertical      VerticalMode  // When to draw one block per row
+	HiddenBorders BorderSide    // Border parts that are not drawn
+	Style         *TableStyle   // Table style (used internally)
 }
 
 // Alignment represents text alignment in a column.
@@ -80,7 +105,31 @@ type TableStyle struct {
 	LeftCross       string // Left intersection
 	RightCross      string // Right intersection
 	HeaderSeparator string // Line under header
-	Name            string // Style name (for debugging)
+
+	// Lines inside the table can have their own characters. Empty fields
+	// fall back to the ones above: junctions to LeftCross, Cross and
+	// RightCross, the T junctions where a spanning cell meets the line to
+	// TopCross and BottomCross, the footer separator line to
+	// HeaderSeparator and the row separator line to Horizontal.
+	HeaderLeft      string // Left intersection of the line under the header
+	HeaderCross     string // Intersection of the line under the header
+	HeaderRight     string // Right intersection of the line under the header
+	HeaderDown      string // T junction of the line under the header, open below
+	HeaderUp        string // T junction of the line under the header, open above
+	FooterSeparator string // Line above the footer
+	FooterLeft      string // Left intersection of the line above the footer
+	FooterCross     string // Intersection of the line above the footer
+	FooterRight     string // Right intersection of the line above the footer
+	FooterDown      string // T junction of the line above the footer, open below
+	FooterUp        string // T junction of the line above the footer, open above
+	RowSeparator    string // Separator line between rows (see AddSeparator)
+	RowLeft         string // Left intersection of row separators
+	RowCross        string // Intersection of row separators
+	RowRight        string // Right intersection of row separators
+	RowDown         string // T junction of row separators, open below
+	RowUp           string // T junction of row separators, open above
+
+	Name string // Style name (for debugging)
 }
 
 // Predefined table styles
@@ -231,6 +280,109 @@ var (
 		HeaderSeparator: "",
 		Name:            "compact",
 	}
+
+	// TableStyleMarkdown uses pipes and dashes like a Markdown table,
+	// without top and bottom borders. For a real Markdown export with
+	// escaping and alignment, see Markdown.
+	//
+	// Example:
+	// | Name     | Age   |
+	// |----------|-------|
+	// | Alice    | 28    |
+	TableStyleMarkdown = &TableStyle{
+		TopLeft:         "",
+		TopRight:        "",
+		BottomLeft:      "",
+		BottomRight:     "",
+		Horizontal:      "-",
+		Vertical:        "|",
+		Cross:           "|",
+		TopCross:        "|",
+		BottomCross:     "|",
+		LeftCross:       "|",
+		RightCross:      "|",
+		HeaderSeparator: "-",
+		Name:            "markdown",
+	}
+
+	// TableStyleHeavyHeader uses light lines with a heavy line under the
+	// header.
+	//
+	// Example:
+	// ┌──────────┬───────┐
+	// │ Name     │ Age   │
+	// ┝━━━━━━━━━━┿━━━━━━━┥
+	// │ Alice    │ 28    │
+	// └──────────┴───────┘
+	TableStyleHeavyHeader = &TableStyle{
+		TopLeft:         "┌",
+		TopRight:        "┐",
+		BottomLeft:      "└",
+		BottomRight:     "┘",
+		Horizontal:      "─",
+		Vertical:        "│",
+		Cross:           "┼",
+		TopCross:        "┬",
+		BottomCross:     "┴",
+		LeftCross:       "├",
+		RightCross:      "┤",
+		HeaderSeparator: "━",
+		HeaderLeft:      "┝",
+		HeaderCross:     "┿",
+		HeaderRight:     "┥",
+		HeaderDown:      "┯",
+		HeaderUp:        "┷",
+		FooterSeparator: "─",
+		Name:            "heavy-header",
+	}
+
+	// TableStyleDashed uses dashed lines with square corners.
+	//
+	// Example:
+	// ┌┄┄┄┄┄┄┄┄┄┄┬┄┄┄┄┄┄┄┐
+	// ┆ Name     ┆ Age   ┆
+	// ├┄┄┄┄┄┄┄┄┄┄┼┄┄┄┄┄┄┄┤
+	// ┆ Alice    ┆ 28    ┆
+	// └┄┄┄┄┄┄┄┄┄┄┴┄┄┄┄┄┄┄┘
+	TableStyleDashed = &TableStyle{
+		TopLeft:         "┌",
+		TopRight:        "┐",
+		BottomLeft:      "└",
+		BottomRight:     "┘",
+		Horizontal:      "┄",
+		Vertical:        "┆",
+		Cross:           "┼",
+		TopCross:        "┬",
+		BottomCross:     "┴",
+		LeftCross:       "├",
+		RightCross:      "┤",
+		HeaderSeparator: "┄",
+		Name:            "dashed",
+	}
+
+	// TableStyleBlock uses full block characters for all lines.
+	//
+	// Example:
+	// ████████████████████
+	// █ Name     █ Age   █
+	// ████████████████████
+	// █ Alice    █ 28    █
+	// ████████████████████
+	TableStyleBlock = &TableStyle{
+		TopLeft:         "█",
+		TopRight:        "█",
+		BottomLeft:      "█",
+		BottomRight:     "█",
+		Horizontal:      "█",
+		Vertical:        "█",
+		Cross:           "█",
+		TopCross:        "█",
+		BottomCross:     "█",
+		LeftCross:       "█",
+		RightCross:      "█",
+		HeaderSeparator: "█",
+		Name:            "block",
+	}
 )
 
 // TableOption is a functional option for configuring a Table.
@@ -301,6 +453,38 @@ func WithAutoSize(enabled bool) TableOption {
 	}
 }
 
+// WithMinWidth sets the minimum width for all columns.
+// Shorter text is padded according to the column alignment.
+func WithMinWidth(width int) TableOption {
+	return func(o *TableOptions) {
+		o.MinWidth = width
+	}
+}
+
+// WithShowBorders enables or disables the table borders.
+//
+// Without borders, columns are separated by padding only.
+func WithShowBorders(show bool) TableOption {
+	return func(o *TableOptions) {
+		o.ShowBorders = show
+	}
+}
+
+// WithShowHeader enables or disables the header row.
+func WithShowHeader(show bool) TableOption {
+	return func(o *TableOptions) {
+		o.ShowHeader = show
+	}
+}
+
+// WithMaxWidth sets the maximum width for all columns.
+// Longer text is wrapped or truncated (see WithOverflow).
+func WithMaxWidth(width int) TableOption {
+	return func(o *TableOptions) {
+		o.MaxWidth = width
+	}
+}
+
 // NewTable creates a new table with optional configuration.
 func NewTable(opts ...TableOption) *Table {
 	options := &TableOptions{
@@ -327,7 +511,7 @@ func NewTable(opts ...TableOption) *Table {
 
 	return &Table{
 		headers:      []string{},
-		rows:         [][]string{},
+		rows:         []tableRow{},
 		footer:       []string{},
 		columnWidths: []int{},
 		style:        style,
@@ -338,24 +522,30 @@ func NewTable(opts ...TableOption) *Table {
 // SetHeaders sets the column headers.
 func (t *Table) SetHeaders(headers ...string) *Table {
 	t.headers = headers
+	t.columnWidths = []int{}
 	return t
 }
 
 // AddRow adds a new data row to the table.
 func (t *Table) AddRow(cells ...string) *Table {
-	t.rows = append(t.rows, cells)
+	t.rows = append(t.rows, tableRow{cells: textCells(cells)})
+	t.columnWidths = []int{}
 	return t
 }
 
 // AddRows adds multiple rows at once.
 func (t *Table) AddRows(rows ...[]string) *Table {
-	t.rows = append(t.rows, rows...)
+	for _, row := range rows {
+		t.AddRow(row...)
+	}
 	return t
 }
 
 // SetFooter sets the footer row.
 func (t *Table) SetFooter(cells ...string) *Table {
 	t.footer = cells
+	t.summary = nil
+	t.columnWidths = []int{}
 	return t
 }
 
@@ -366,7 +556,8 @@ func (t *Table) AddStyledRow(cells ...string) *Table {
 
 // AddSeparator adds a visual separator line between rows.
 func (t *Table) AddSeparator() *Table {
-	t.rows = append(t.rows, []string{"__SEPARATOR__"})
+	t.rows = append(t.rows, tableRow{separator: true})
+	t.columnWidths = []int{}
 	return t
 }
 
@@ -380,19 +571,21 @@ func (t *Table) calculateColumnWidths() {
 	}
 
 	numCols := t.determineColumnCount()
-	if numCols == 0 {
+	if numCols == 0 && len(t.options.ColumnWidths) == 0 {
 		return
 	}
 
-	if t.useFixedWidths() {
-		return
+	if !t.useFixedWidths() {
+		t.initializeWidths(numCols)
+		layout := t.rowLayout(numCols)
+		t.updateWidthsFromHeaders()
+		t.updateWidthsFromRows(layout)
+		t.updateWidthsFromFooter()
+		t.updateWidthsFromSpans(layout)
+		t.applyWidthConstraints()
 	}
 
-	t.initializeWidths(numCols)
-	t.updateWidthsFromHeaders()
-	t.updateWidthsFromRows(numCols)
-	t.updateWidthsFromFooter(numCols)
-	t.applyWidthConstraints()
+	t.fitToWidth()
 }
 
 // determineColumnCount returns the number of columns in the table.
@@ -401,7 +594,7 @@ func (t *Table) determineColumnCount() int {
 		return len(t.headers)
 	}
 	if len(t.rows) > 0 {
-		return len(t.rows[0])
+		return t.rows[0].width()
 	}
 	return 0
 }
@@ -425,90 +618,96 @@ func (t *Table) initializeWidths(numCols int) {
 func (t *Table) updateWidthsFromHeaders() {
 	for i, header := range t.headers {
 		if i < len(t.columnWidths) {
-			t.columnWidths[i] = visualWidth(header)
+			t.columnWidths[i] = cellWidth(header)
 		}
 	}
 }
 
 // updateWidthsFromRows updates column widths based on row data.
-func (t *Table) updateWidthsFromRows(numCols int) {
-	for _, row := range t.rows {
-		if t.isSeparatorRow(row) {
-			continue
-		}
-		t.updateWidthsFromCells(row, numCols)
+func (t *Table) updateWidthsFromRows(layout [][]cellSlot) {
+	for _, slots := range layout {
+		t.updateWidthsFromSlots(slots)
 	}
 }
 
-// isSeparatorRow checks if a row is a separator.
-func (t *Table) isSeparatorRow(row []string) bool {
-	return len(row) == 1 && row[0] == "__SEPARATOR__"
-}
-
-// updateWidthsFromCells updates widths from a single row of cells.
-func (t *Table) updateWidthsFromCells(cells []string, numCols int) {
-	for i, cell := range cells {
-		if i >= numCols {
-			break
+// updateWidthsFromSlots updates widths from a single row of cells.
+// Cells spanning several columns are handled by updateWidthsFromSpans.
+func (t *Table) updateWidthsFromSlots(slots []cellSlot) {
+	for _, slot := range slots {
+		if slot.continued || slot.start != slot.end {
+			continue
 		}
-		cellLen := visualWidth(cell)
-		if cellLen > t.columnWidths[i] {
-			t.columnWidths[i] = cellLen
+		cellLen := cellWidth(t.applyFormat(slot.start, slot.cell.text))
+		if cellLen > t.columnWidths[slot.start] {
+			t.columnWidths[slot.start] = cellLen
 		}
 	}
 }
 
 // updateWidthsFromFooter updates column widths based on footer text.
-func (t *Table) updateWidthsFromFooter(numCols int) {
-	t.updateWidthsFromCells(t.footer, numCols)
+func (t *Table) updateWidthsFromFooter() {
+	t.updateWidthsFromSlots(t.cellSlots(textCells(t.footerCells())))
 }
 
 // applyWidthConstraints applies min/max width constraints to columns.
 func (t *Table) applyWidthConstraints() {
 	for i := range t.columnWidths {
-		t.columnWidths[i] = t.constrainWidth(t.columnWidths[i])
+		t.columnWidths[i] = t.constrainWidth(i, t.columnWidths[i])
 	}
 }
 
-// constrainWidth applies min/max constraints to a single width value.
-func (t *Table) constrainWidth(width int) int {
-	if t.options.MinWidth > 0 && width < t.options.MinWidth {
-		return t.options.MinWidth
+// constrainWidth applies min/max constraints to the width of a column.
+// Column settings (see SetColumns) take precedence over table-wide ones.
+func (t *Table) constrainWidth(col, width int) int {
+	column := t.column(col)
+	if column.Width > 0 {
+		return column.Width
 	}
-	if t.options.MaxWidth > 0 && width > t.options.MaxWidth {
-		return t.options.MaxWidth
+
+	minWidth, maxWidth := t.options.MinWidth, t.options.MaxWidth
+	if column.MinWidth > 0 {
+		minWidth = column.MinWidth
+	}
+	if column.MaxWidth > 0 {
+		maxWidth = column.MaxWidth
+	}
+
+	if minWidth > 0 && width < minWidth {
+		return minWidth
+	}
+	if maxWidth > 0 && width > maxWidth {
+		return maxWidth
 	}
 	return width
 }
 
 // stripANSI removes ANSI color codes from a string to get actual display length.
+//
+// The string is processed byte by byte; non-escape bytes are copied
+// unchanged so multi-byte UTF-8 characters stay intact.
 func stripANSI(str string) string {
-	result := ""
+	var result strings.Builder
 	inEscape := false
-	escapeSeq := ""
 
 	for i := 0; i < len(str); i++ {
 		char := str[i]
 
 		if char == 0x1b {
 			inEscape = true
-			escapeSeq = ""
 			continue
 		}
 
 		if inEscape {
-			escapeSeq += string(char)
 			if (char >= 'A' && char <= 'Z') || (char >= 'a' && char <= 'z') {
 				inEscape = false
-				escapeSeq = ""
 			}
 			continue
 		}
 
-		result += string(char)
+		result.WriteByte(char)
 	}
 
-	return result
+	return result.String()
 }
 
 // REFACTORED: visualWidth - reduced complexity from 22 to <15
@@ -623,6 +822,9 @@ func (t *Table) alignText(text string, width int, alignment Alignment) string {
 
 // getAlignment returns the alignment for a specific column.
 func (t *Table) getAlignment(col int) Alignment {
+	if col < len(t.columns) {
+		return t.columns[col].Align
+	}
 	if col < len(t.options.Alignment) {
 		return t.options.Alignment[col]
 	}
@@ -666,47 +868,78 @@ func (t *Table) colorize(text, color string) string {
 	if color == "" {
 		return text
 	}
+	if t.writer != nil {
+		return colorizeIf(t.colorEnabled(), text, color)
+	}
 	return tableColorize(text, color)
 }
 
-// buildBorder creates a border line (top, middle, or bottom).
-func (t *Table) buildBorder(left, cross, right, horizontal string) string {
-	if !t.options.ShowBorders || left == "" {
-		return ""
+// colorEnabled checks if colors are enabled for the table output: the
+// writer of a streaming table (see Stream), or stdout.
+func (t *Table) colorEnabled() bool {
+	if t.writer != nil {
+		return isColorEnabledFor(t.writer)
 	}
+	return tableIsColorEnabled()
+}
 
-	parts := []string{left}
-	for i, width := range t.columnWidths {
-		parts = append(parts, strings.Repeat(horizontal, width+2*t.options.Padding))
-		if i < len(t.columnWidths)-1 {
-			parts = append(parts, cross)
-		}
-	}
-	parts = append(parts, right)
+// rowKind distinguishes header, data and footer rows, which differ in
+// alignment, formatting and colors.
+type rowKind int
 
-	line := strings.Join(parts, "")
-	return t.colorize(line, t.options.BorderColor)
-}
+const (
+	bodyRow rowKind = iota
+	headerRow
+	footerRow
+)
 
 // buildRow creates a formatted row of data.
-func (t *Table) buildRow(cells []string, color string) string {
+func (t *Table) buildRow(kind rowKind, cells []cellSlot, color string) string {
 	if len(cells) == 0 {
 		return ""
 	}
 
-	if len(cells) == 1 && cells[0] == "__SEPARATOR__" {
-		return t.buildBorder(
-			t.style.LeftCross,
-			t.style.Cross,
-			t.style.RightCross,
-			t.style.Horizontal,
-		)
+	verticalBorder := t.getVerticalBorder()
+
+	align := t.getAlignment
+	if kind == headerRow {
+		align = t.getHeaderAlignment
 	}
 
-	verticalBorder := t.getVerticalBorder()
-	parts := t.buildRowParts(cells, color, verticalBorder)
+	// Wrap every cell into lines; the row is as tall as its tallest cell
+	slots := []cellSlot{}
+	columns := [][]string{}
+	height := 1
+	for _, slot := range cells {
+		if !t.slotVisible(slot) {
+			continue
+		}
+		cell := slot.cell.text
+		switch {
+		case slot.continued:
+			cell = "" // Shown in the first row of the span
+		case kind != headerRow:
+			cell = t.applyFormat(slot.start, cell)
+		}
+		cell = t.colorizeCell(cell, t.cellColor(kind, slot.start, slot.cell, color))
+		lines := t.cellLines(cell, slot.start, t.slotWidth(slot))
+		slots = append(slots, slot)
+		columns = append(columns, lines)
+		height = maxInt(height, len(lines))
+	}
 
-	return strings.Join(parts, "")
+	lines := make([]string, height)
+	for l := range lines {
+		lineCells := make([]string, len(columns))
+		for i, column := range columns {
+			if l < len(column) {
+				lineCells[i] = column[l]
+			}
+		}
+		lines[l] = strings.Join(t.buildRowParts(slots, lineCells, verticalBorder, align), "")
+	}
+
+	return strings.Join(lines, "\n")
 }
 
 // getVerticalBorder returns the vertical border string with color applied.
@@ -718,16 +951,17 @@ func (t *Table) getVerticalBorder() string {
 }
 
 // buildRowParts constructs the parts of a row (borders and cells).
-func (t *Table) buildRowParts(cells []string, color, verticalBorder string) []string {
+// Cells must already be colored.
+func (t *Table) buildRowParts(slots []cellSlot, cells []string, verticalBorder string, align func(int) Alignment) []string {
 	parts := []string{}
 
-	if t.options.ShowBorders {
+	if t.showsBorder(BorderLeft) {
 		parts = append(parts, verticalBorder)
 	}
 
-	parts = t.appendCellParts(parts, cells, color, verticalBorder)
+	parts = t.appendCellParts(parts, slots, cells, verticalBorder, align)
 
-	if t.options.ShowBorders {
+	if t.showsBorder(BorderRight) {
 		parts = append(parts, verticalBorder)
 	}
 
@@ -735,47 +969,47 @@ func (t *Table) buildRowParts(cells []string, color, verticalBorder string) []st
 }
 
 // appendCellParts adds formatted cells to the parts slice.
-func (t *Table) appendCellParts(parts []string, cells []string, color, verticalBorder string) []string {
-	for i := 0; i < len(t.columnWidths); i++ {
-		cell := t.getCellContent(cells, i)
-		displayCell := t.colorizeCell(cell, color)
-		formattedCell := t.formatCell(displayCell, i)
-
-		parts = append(parts, formattedCell)
+func (t *Table) appendCellParts(parts []string, slots []cellSlot, cells []string, verticalBorder string, align func(int) Alignment) []string {
+	for i, slot := range slots {
+		alignment := align(slot.start)
+		if slot.cell.aligned {
+			alignment = slot.cell.align
+		}
+		parts = append(parts, t.formatCell(cells[i], t.slotWidth(slot), alignment))
 
-		if t.shouldAddVerticalBorder(i) {
+		if t.shouldAddVerticalBorder(slot.end) {
 			parts = append(parts, verticalBorder)
 		}
 	}
 	return parts
 }
 
-// getCellContent retrieves cell content or returns empty string.
-func (t *Table) getCellContent(cells []string, index int) string {
-	if index < len(cells) {
-		return cells[index]
-	}
-	return ""
-}
-
 // colorizeCell applies color to a cell if needed.
+//
+// Cells may contain their own colors. The color is reapplied after every
+// reset inside the cell, so it continues after embedded colored text.
 func (t *Table) colorizeCell(cell, color string) string {
-	if color == "" || strings.Contains(cell, "\x1b[") {
+	if color == "" || !t.colorEnabled() {
 		return cell
 	}
-	return t.colorize(cell, color)
+	return wrapColor(cell, color)
+}
+
+// wrapColor wraps text in a color that continues after embedded resets.
+func wrapColor(text, color string) string {
+	return color + strings.ReplaceAll(text, Reset, Reset+color) + Reset
 }
 
 // formatCell formats a cell with padding and alignment.
-func (t *Table) formatCell(displayCell string, columnIndex int) string {
+func (t *Table) formatCell(displayCell string, width int, alignment Alignment) string {
 	padding := strings.Repeat(" ", t.options.Padding)
-	aligned := t.alignText(displayCell, t.columnWidths[columnIndex], t.getAlignment(columnIndex))
+	aligned := t.alignText(displayCell, width, alignment)
 	return padding + aligned + padding
 }
 
 // shouldAddVerticalBorder checks if a vertical border should be added after this column.
 func (t *Table) shouldAddVerticalBorder(columnIndex int) bool {
-	return t.options.ShowBorders && columnIndex < len(t.columnWidths)-1
+	return t.showsBorder(BorderInnerVertical) && columnIndex < t.lastVisibleColumn()
 }
 
 // REFACTORED: String - reduced complexity from 16 to <15
@@ -783,77 +1017,96 @@ func (t *Table) shouldAddVerticalBorder(columnIndex int) bool {
 
 // String returns the table as a formatted string.
 func (t *Table) String() string {
+	if t.isVertical() {
+		return t.verticalString()
+	}
+
 	t.calculateColumnWidths()
+	layout := t.rowLayout(len(t.columnWidths))
 
 	var output strings.Builder
 
-	t.writeTopBorder(&output)
-	t.writeHeader(&output)
-	t.writeRows(&output)
-	t.writeFooter(&output)
-	t.writeBottomBorder(&output)
+	t.writeHead(&output, layout)
+	t.writeBody(&output, layout)
 
 	return output.String()
 }
 
+// writeHead writes the title, the top border and the header.
+func (t *Table) writeHead(output *strings.Builder, layout [][]cellSlot) {
+	t.writeTitle(output)
+	t.writeTopBorder(output, layout)
+	t.writeHeader(output, layout)
+}
+
+// writeBody writes the rows, the footer, the bottom border and the
+// caption.
+func (t *Table) writeBody(output *strings.Builder, layout [][]cellSlot) {
+	t.writeRows(output, layout)
+	t.writeFooter(output, layout)
+	t.writeBottomBorder(output, t.firstSlots(t.footerSlots(), bodySlots(layout, len(layout)-1, -1), t.headerSlots()))
+	t.writeCaption(output, t.tableWidth())
+}
+
 // writeTopBorder writes the top border of the table.
-func (t *Table) writeTopBorder(output *strings.Builder) {
-	if !t.options.ShowBorders || t.style.TopLeft == "" {
+func (t *Table) writeTopBorder(output *strings.Builder, layout [][]cellSlot) {
+	if !t.showsLine(topLine) {
 		return
 	}
-	output.WriteString(t.buildBorder(
-		t.style.TopLeft,
-		t.style.TopCross,
-		t.style.TopRight,
-		t.style.Horizontal,
-	))
+	below := t.firstSlots(t.headerSlots(), bodySlots(layout, 0, 1), t.footerSlots())
+	if t.titleInBorder() {
+		output.WriteString(t.titleBorder(t.drawLine(nil, below, topLine)))
+	} else {
+		output.WriteString(t.buildLine(nil, below, topLine))
+	}
 	output.WriteString("\n")
 }
 
 // writeHeader writes the header row and its separator.
-func (t *Table) writeHeader(output *strings.Builder) {
-	if !t.options.ShowHeader || len(t.headers) == 0 {
+func (t *Table) writeHeader(output *strings.Builder, layout [][]cellSlot) {
+	header := t.headerSlots()
+	if header == nil {
 		return
 	}
 
-	headerRow := t.buildRow(t.headers, t.options.HeaderColor)
+	headerRow := t.buildRow(headerRow, header, t.options.HeaderColor)
 	output.WriteString(headerRow)
 	output.WriteString("\n")
 
-	t.writeHeaderSeparator(output)
+	t.writeHeaderSeparator(output, header, t.firstSlots(bodySlots(layout, 0, 1), t.footerSlots()))
 }
 
 // writeHeaderSeparator writes the separator line after the header.
-func (t *Table) writeHeaderSeparator(output *strings.Builder) {
-	if !t.options.ShowBorders || t.style.HeaderSeparator == "" {
+func (t *Table) writeHeaderSeparator(output *strings.Builder, above, below []cellSlot) {
+	if !t.showsLine(headerLine) {
 		return
 	}
-	output.WriteString(t.buildBorder(
-		t.style.LeftCross,
-		t.style.Cross,
-		t.style.RightCross,
-		t.style.HeaderSeparator,
-	))
+	output.WriteString(t.buildLine(above, below, headerLine))
 	output.WriteString("\n")
 }
 
 // writeRows writes all data rows.
-func (t *Table) writeRows(output *strings.Builder) {
+func (t *Table) writeRows(output *strings.Builder, layout [][]cellSlot) {
 	for i, row := range t.rows {
-		t.writeRow(output, row, i)
+		t.writeRow(output, layout, row, i)
 	}
 }
 
 // writeRow writes a single data row.
-func (t *Table) writeRow(output *strings.Builder, row []string, rowIndex int) {
-	if t.isSeparatorRow(row) {
-		output.WriteString(t.buildRow(row, ""))
+func (t *Table) writeRow(output *strings.Builder, layout [][]cellSlot, row tableRow, rowIndex int) {
+	if row.separator {
+		if t.options.HiddenBorders&BorderInnerHorizontal != 0 {
+			return
+		}
+		above := t.firstSlots(bodySlots(layout, rowIndex, -1), t.headerSlots())
+		below := t.firstSlots(bodySlots(layout, rowIndex, 1), t.footerSlots())
+		output.WriteString(t.buildLine(above, below, rowLine))
 		output.WriteString("\n")
 		return
 	}
 
-	rowColor := t.getRowColor(rowIndex)
-	output.WriteString(t.buildRow(row, rowColor))
+	rowColor := t.getRowColor(t.firstRow+rowIndex) + styleCodes(row.style)
+	output.WriteString(t.buildRow(bodyRow, layout[rowIndex], rowColor))
 	output.WriteString("\n")
 }
 
@@ -866,42 +1119,33 @@ func (t *Table) getRowColor(rowIndex int) string {
 }
 
 // writeFooter writes the footer row with its separator.
-func (t *Table) writeFooter(output *strings.Builder) {
-	if len(t.footer) == 0 {
+func (t *Table) writeFooter(output *strings.Builder, layout [][]cellSlot) {
+	footer := t.footerSlots()
+	if footer == nil {
 		return
 	}
 
-	t.writeFooterSeparator(output)
+	t.writeFooterSeparator(output, t.firstSlots(bodySlots(layout, len(layout)-1, -1), t.headerSlots()), footer)
 
-	output.WriteString(t.buildRow(t.footer, t.options.FooterColor))
+	output.WriteString(t.buildRow(footerRow, footer, t.options.FooterColor))
 	output.WriteString("\n")
 }
 
 // writeFooterSeparator writes the separator line before the footer.
-func (t *Table) writeFooterSeparator(output *strings.Builder) {
-	if !t.options.ShowBorders || t.style.HeaderSeparator == "" {
+func (t *Table) writeFooterSeparator(output *strings.Builder, above, below []cellSlot) {
+	if !t.showsLine(footerLine) {
 		return
 	}
-	output.WriteString(t.buildBorder(
-		t.style.LeftCross,
-		t.style.Cross,
-		t.style.RightCross,
-		t.style.HeaderSeparator,
-	))
+	output.WriteString(t.buildLine(above, below, footerLine))
 	output.WriteString("\n")
 }
 
 // writeBottomBorder writes the bottom border of the table.
-func (t *Table) writeBottomBorder(output *strings.Builder) {
-	if !t.options.ShowBorders || t.style.BottomLeft == "" {
+func (t *Table) writeBottomBorder(output *strings.Builder, above []cellSlot) {
+	if !t.showsLine(bottomLine) {
 		return
 	}
-	output.WriteString(t.buildBorder(
-		t.style.BottomLeft,
-		t.style.BottomCross,
-		t.style.BottomRight,
-		t.style.Horizontal,
-	))
+	output.WriteString(t.buildLine(above, nil, bottomLine))
 	output.WriteString("\n")
 }
 
@@ -912,7 +1156,7 @@ func (t *Table) Print() {
 
 // Clear removes all rows but keeps headers and configuration.
 func (t *Table) Clear() *Table {
-	t.rows = [][]string{}
+	t.rows = []tableRow{}
 	t.columnWidths = []int{}
 	return t
 }
@@ -928,7 +1172,7 @@ func (t *Table) ColumnCount() int {
 		return len(t.headers)
 	}
 	if len(t.rows) > 0 {
-		return len(t.rows[0])
+		return t.rows[0].width()
 	}
 	return 0
 }
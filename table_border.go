@@ -0,0 +1,134 @@
+// table_border.go
+package colorbear
+
+// BorderSide selects parts of the table border. Combine sides with |.
+type BorderSide int
+
+const (
+	BorderTop             BorderSide = 1 << iota // Line above the table
+	BorderRight                                  // Line right of the last column
+	BorderBottom                                 // Line below the table
+	BorderLeft                                   // Line left of the first column
+	BorderInnerVertical                          // Lines between columns
+	BorderInnerHorizontal                        // Header, footer and row separator lines
+
+	BorderOuter = BorderTop | BorderRight | BorderBottom | BorderLeft // Frame around the table
+	BorderInner = BorderInnerVertical | BorderInnerHorizontal         // Lines inside the table
+	BorderAll   = BorderOuter | BorderInner                           // All lines (default)
+)
+
+// WithBorderSides sets which parts of the border are drawn. Parts that
+// are left out take no space; the style decides how the rest looks.
+// Without BorderInnerHorizontal, separator rows (AddSeparator) are left
+// out too.
+//
+// Example:
+//
+//	// No frame around the table
+//	colorbear.WithBorderSides(colorbear.BorderInner)
+//
+//	// Only lines between the columns
+//	colorbear.WithBorderSides(colorbear.BorderInnerVertical)
+//	// Output:
+//	//  Name  │ Age
+//	//  Alice │ 28
+func WithBorderSides(sides BorderSide) TableOption {
+	return func(o *TableOptions) {
+		o.HiddenBorders = BorderAll &^ sides
+	}
+}
+
+// showsBorder reports whether a part of the border is drawn.
+func (t *Table) showsBorder(side BorderSide) bool {
+	return t.options.ShowBorders && t.options.HiddenBorders&side == 0
+}
+
+// verticalWidth returns the width of a vertical line that is drawn, or 0
+// if it is not.
+func (t *Table) verticalWidth(side BorderSide) int {
+	if !t.showsBorder(side) {
+		return 0
+	}
+	return visualWidth(t.style.Vertical)
+}
+
+// lineKind is the kind of a horizontal line of the table.
+type lineKind int
+
+const (
+	topLine    lineKind = iota // Top border
+	headerLine                 // Below the header
+	rowLine                    // Separator between rows
+	footerLine                 // Above the footer
+	bottomLine                 // Bottom border
+)
+
+// lineChars are the characters of a horizontal line: the line itself and
+// the junctions where it meets vertical lines on both sides, or only below
+// (down) or above (up) where a cell spans columns.
+type lineChars struct {
+	horizontal string
+	left       string
+	cross      string
+	right      string
+	down       string
+	up         string
+}
+
+// lineChars returns the characters of a kind of line, with the fallbacks
+// described at TableStyle.
+func (t *Table) lineChars(kind lineKind) lineChars {
+	s := t.style
+	chars := lineChars{
+		horizontal: s.Horizontal,
+		left:       s.LeftCross,
+		cross:      s.Cross,
+		right:      s.RightCross,
+		down:       s.TopCross,
+		up:         s.BottomCross,
+	}
+	switch kind {
+	case headerLine:
+		chars = chars.with(s.HeaderLeft, s.HeaderCross, s.HeaderRight, s.HeaderDown, s.HeaderUp)
+		chars.horizontal = s.HeaderSeparator // Styles without one have no line
+	case footerLine:
+		chars = chars.with(s.FooterLeft, s.FooterCross, s.FooterRight, s.FooterDown, s.FooterUp)
+		chars.horizontal = firstNonEmpty(s.FooterSeparator, s.HeaderSeparator)
+	case rowLine:
+		chars = chars.with(s.RowLeft, s.RowCross, s.RowRight, s.RowDown, s.RowUp)
+		chars.horizontal = firstNonEmpty(s.RowSeparator, s.Horizontal)
+	}
+	return chars
+}
+
+// with replaces the junctions that are set.
+func (c lineChars) with(left, cross, right, down, up string) lineChars {
+	c.left = firstNonEmpty(left, c.left)
+	c.cross = firstNonEmpty(cross, c.cross)
+	c.right = firstNonEmpty(right, c.right)
+	c.down = firstNonEmpty(down, c.down)
+	c.up = firstNonEmpty(up, c.up)
+	return c
+}
+
+// firstNonEmpty returns the first string that is not empty.
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// showsLine reports whether a kind of line is drawn: the border part is
+// enabled and the style has characters for it.
+func (t *Table) showsLine(kind lineKind) bool {
+	switch kind {
+	case topLine:
+		return t.showsBorder(BorderTop) && t.style.TopLeft != ""
+	case bottomLine:
+		return t.showsBorder(BorderBottom) && t.style.BottomLeft != ""
+	}
+	return t.showsBorder(BorderInnerHorizontal) && t.lineChars(kind).horizontal != ""
+}
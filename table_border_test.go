@@ -0,0 +1,179 @@
+package colorbear
+
+import (
+	"strings"
+	"testing"
+)
+
+func newBorderTestTable(opts ...TableOption) *Table {
+	table := NewTable(opts...)
+	table.SetHeaders("Name", "Age")
+	table.AddRow("Alice", "28")
+	table.AddSeparator()
+	table.AddRow("Bob", "34")
+	table.SetFooter("Total", "62")
+	return table
+}
+
+func TestTableStyleMarkdown(t *testing.T) {
+	table := NewTable(WithTableStyle(TableStyleMarkdown))
+	table.SetHeaders("Name", "Age")
+	table.AddRow("Alice", "28")
+
+	expected := "| Name  | Age |\n" +
+		"|-------|-----|\n" +
+		"| Alice | 28  |\n"
+	if output := stripANSI(table.String()); output != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, output)
+	}
+}
+
+func TestTableStyleHeavyHeader(t *testing.T) {
+	output := stripANSI(newBorderTestTable(WithTableStyle(TableStyleHeavyHeader)).String())
+	expected := "┌───────┬─────┐\n" +
+		"│ Name  │ Age │\n" +
+		"┝━━━━━━━┿━━━━━┥\n" +
+		"│ Alice │ 28  │\n" +
+		"├───────┼─────┤\n" +
+		"│ Bob   │ 34  │\n" +
+		"├───────┼─────┤\n" +
+		"│ Total │ 62  │\n" +
+		"└───────┴─────┘\n"
+	if output != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, output)
+	}
+}
+
+func TestTableStyleLineOverrides(t *testing.T) {
+	style := *TableStyleRounded
+	style.FooterSeparator = "═"
+	style.FooterLeft, style.FooterCross, style.FooterRight = "╞", "╪", "╡"
+	style.RowSeparator = "┈"
+	style.RowCross = "┊"
+
+	lines := strings.Split(stripANSI(newBorderTestTable(WithTableStyle(&style)).String()), "\n")
+	if lines[2] != "├───────┼─────┤" {
+		t.Errorf("Expected the header line to keep the defaults, got %q", lines[2])
+	}
+	if lines[4] != "├┈┈┈┈┈┈┈┊┈┈┈┈┈┤" {
+		t.Errorf("Expected the row separator characters, got %q", lines[4])
+	}
+	if lines[6] != "╞═══════╪═════╡" {
+		t.Errorf("Expected the footer separator characters, got %q", lines[6])
+	}
+}
+
+func TestTableBorderSides(t *testing.T) {
+	tests := []struct {
+		name     string
+		sides    BorderSide
+		expected string
+	}{
+		{
+			"no outer border",
+			BorderInner,
+			" Name  │ Age \n" +
+				"───────┼─────\n" +
+				" Alice │ 28  \n" +
+				"───────┼─────\n" +
+				" Bob   │ 34  \n" +
+				"───────┼─────\n" +
+				" Total │ 62  \n",
+		},
+		{
+			"inner verticals only",
+			BorderInnerVertical,
+			" Name  │ Age \n" +
+				" Alice │ 28  \n" +
+				" Bob   │ 34  \n" +
+				" Total │ 62  \n",
+		},
+		{
+			"frame only",
+			BorderOuter,
+			"╭────────────╮\n" +
+				"│ Name   Age │\n" +
+				"│ Alice  28  │\n" +
+				"│ Bob    34  │\n" +
+				"│ Total  62  │\n" +
+				"╰────────────╯\n",
+		},
+		{
+			"top and bottom",
+			BorderTop | BorderBottom | BorderInnerHorizontal,
+			"────────────\n" +
+				" Name   Age \n" +
+				"────────────\n" +
+				" Alice  28  \n" +
+				"────────────\n" +
+				" Bob    34  \n" +
+				"────────────\n" +
+				" Total  62  \n" +
+				"────────────\n",
+		},
+	}
+
+	for _, tt := range tests {
+		output := stripANSI(newBorderTestTable(WithBorderSides(tt.sides)).String())
+		if output != tt.expected {
+			t.Errorf("%s: expected:\n%s\ngot:\n%s", tt.name, tt.expected, output)
+		}
+	}
+}
+
+func TestTableBorderSidesWidth(t *testing.T) {
+	table := newBorderTestTable(WithBorderSides(BorderInnerVertical))
+	lines := strings.Split(stripANSI(table.String()), "\n")
+	if width := table.tableWidth(); width != visualWidth(lines[0]) {
+		t.Errorf("Expected table width %d to match the output %q", width, lines[0])
+	}
+}
+
+func TestTableBorderSidesColspan(t *testing.T) {
+	table := NewTable(WithBorderSides(BorderInner))
+	table.SetHeaders("A", "B")
+	table.AddCells(NewCell("spanning both").Colspan(2))
+
+	lines := strings.Split(stripANSI(table.String()), "\n")
+	if visualWidth(lines[0]) != visualWidth(lines[2]) {
+		t.Errorf("Expected the spanning row as wide as the header, got %q and %q", lines[0], lines[2])
+	}
+}
+
+func TestTableStyleHeavyHeaderColspan(t *testing.T) {
+	table := NewTable(WithTableStyle(TableStyleHeavyHeader))
+	table.SetHeaders("A", "B", "C")
+	table.AddCells(NewCell("wide").Colspan(2), NewCell("x"))
+	table.AddSeparator()
+	table.AddRow("1", "2", "3")
+
+	lines := strings.Split(stripANSI(table.String()), "\n")
+	if lines[2] != "┝━━━┷━━━┿━━━┥" {
+		t.Errorf("Expected a heavy T junction where the span meets the header line, got %q", lines[2])
+	}
+	if lines[4] != "├───┬───┼───┤" {
+		t.Errorf("Expected light T junctions on the row separator, got %q", lines[4])
+	}
+}
+
+func TestTableStyleRowJunctionOverrides(t *testing.T) {
+	style := *TableStyleRounded
+	style.RowSeparator = "═"
+	style.RowCross, style.RowDown, style.RowUp = "╪", "╤", "╧"
+
+	table := NewTable(WithTableStyle(&style))
+	table.SetHeaders("A", "B", "C")
+	table.AddRow("1", "2", "3")
+	table.AddSeparator()
+	table.AddCells(NewCell("wide").Colspan(2), NewCell("x"))
+	table.AddSeparator()
+	table.AddRow("4", "5", "6")
+
+	lines := strings.Split(stripANSI(table.String()), "\n")
+	if lines[4] != "├═══╧═══╪═══┤" {
+		t.Errorf("Expected the up T junction of row separators, got %q", lines[4])
+	}
+	if lines[6] != "├═══╤═══╪═══┤" {
+		t.Errorf("Expected the down T junction of row separators, got %q", lines[6])
+	}
+}
@@ -0,0 +1,333 @@
+// table_cell.go
+package colorbear
+
+import (
+	"strings"
+)
+
+// Cell is a table cell with its own style, alignment, column or row span.
+//
+// Plain rows added with AddRow are made of cells with default settings.
+// Use NewCell and AddCells when single cells need more control.
+//
+// Example:
+//
+//	table.AddCells(
+//	    colorbear.NewCell("api"),
+//	    colorbear.NewCell("FAILED").Style(colorbear.NewStyle().Red().Bold()),
+//	    colorbear.NewCell("12").Align(colorbear.AlignRight),
+//	)
+//	table.AddCells(colorbear.NewCell("Maintenance until 18:00").Colspan(3))
+type Cell struct {
+	text    string
+	style   *Style
+	align   Alignment
+	aligned bool // Whether align overrides the column alignment
+	colspan int
+	rowspan int
+}
+
+// NewCell creates a cell with the given text.
+func NewCell(text string) *Cell {
+	return &Cell{text: text, colspan: 1}
+}
+
+// Style sets the style of the cell. It is applied on top of the row,
+// column and rule styles, so it wins where they conflict.
+//
+// Example:
+//
+//	colorbear.NewCell("down").Style(colorbear.NewStyle().Red().Bold())
+func (c *Cell) Style(style *Style) *Cell {
+	c.style = style
+	return c
+}
+
+// Align overrides the alignment of the column for this cell.
+//
+// Example:
+//
+//	colorbear.NewCell("n/a").Align(colorbear.AlignCenter)
+func (c *Cell) Align(alignment Alignment) *Cell {
+	c.align = alignment
+	c.aligned = true
+	return c
+}
+
+// Colspan lets the cell span several columns. The spanned columns are
+// skipped when the following cells of the row are assigned to columns.
+//
+// Example:
+//
+//	// One cell across the whole three-column table
+//	table.AddCells(colorbear.NewCell("No results").Colspan(3).Align(colorbear.AlignCenter))
+func (c *Cell) Colspan(columns int) *Cell {
+	c.colspan = columns
+	return c
+}
+
+// Text returns the text of the cell.
+func (c *Cell) Text() string {
+	return c.text
+}
+
+// span returns the number of columns the cell spans (at least 1).
+func (c Cell) span() int {
+	return maxInt(1, c.colspan)
+}
+
+// tableRow is a data row or a separator.
+type tableRow struct {
+	cells     []Cell
+	style     *Style // Style of the whole row (see SetRowStyle)
+	separator bool
+}
+
+// textCells turns plain strings into cells with default settings.
+func textCells(texts []string) []Cell {
+	cells := make([]Cell, len(texts))
+	for i, text := range texts {
+		cells[i] = Cell{text: text, colspan: 1}
+	}
+	return cells
+}
+
+// width returns the number of columns the row covers.
+func (r tableRow) width() int {
+	width := 0
+	for _, cell := range r.cells {
+		width += cell.span()
+	}
+	return width
+}
+
+// texts returns the text of every column: spanning cells in their first
+// column, empty strings in the columns they cover.
+func (r tableRow) texts() []string {
+	texts := make([]string, 0, r.width())
+	for _, cell := range r.cells {
+		texts = append(texts, cell.text)
+		for i := 1; i < cell.span(); i++ {
+			texts = append(texts, "")
+		}
+	}
+	return texts
+}
+
+// text returns the text in a column, or an empty string.
+func (r tableRow) text(col int) string {
+	start := 0
+	for _, cell := range r.cells {
+		if col == start {
+			return cell.text
+		}
+		start += cell.span()
+		if col < start {
+			return ""
+		}
+	}
+	return ""
+}
+
+// cellSlot is a cell placed in the columns start to end (inclusive).
+type cellSlot struct {
+	cell       Cell
+	start, end int
+	continued  bool // The cell spans down from the row above
+}
+
+// cellSlots places the cells of a row in the columns of the table. Spans
+// are cut at the last column, missing cells are filled with empty ones.
+func (t *Table) cellSlots(cells []Cell) []cellSlot {
+	numCols := len(t.columnWidths)
+	slots := []cellSlot{}
+	col := 0
+	for _, cell := range cells {
+		if col >= numCols {
+			break
+		}
+		end := minInt(col+cell.span(), numCols) - 1
+		slots = append(slots, cellSlot{cell: cell, start: col, end: end})
+		col = end + 1
+	}
+	for ; col < numCols; col++ {
+		slots = append(slots, cellSlot{cell: Cell{colspan: 1}, start: col, end: col})
+	}
+	return slots
+}
+
+// slotVisible reports whether any column of a slot is shown.
+func (t *Table) slotVisible(slot cellSlot) bool {
+	for i := slot.start; i <= slot.end; i++ {
+		if !t.isHidden(i) {
+			return true
+		}
+	}
+	return false
+}
+
+// slotWidth returns the text width of a slot: the widths of its visible
+// columns plus the padding and borders between them.
+func (t *Table) slotWidth(slot cellSlot) int {
+	width, count := 0, 0
+	for i := slot.start; i <= slot.end; i++ {
+		if !t.isHidden(i) {
+			width += t.columnWidths[i]
+			count++
+		}
+	}
+	return width + maxInt(0, count-1)*t.columnGap()
+}
+
+// columnGap returns the width between the text of two adjacent columns.
+func (t *Table) columnGap() int {
+	return 2*t.options.Padding + t.verticalWidth(BorderInnerVertical)
+}
+
+// updateWidthsFromSpans widens the columns under spanning cells whose
+// text does not fit, spreading the missing width evenly.
+func (t *Table) updateWidthsFromSpans(layout [][]cellSlot) {
+	for _, slots := range layout {
+		for _, slot := range slots {
+			if slot.continued || slot.start == slot.end {
+				continue
+			}
+			missing := cellWidth(t.applyFormat(slot.start, slot.cell.text)) - t.slotWidth(slot)
+			for i := 0; missing > 0; i++ {
+				t.columnWidths[slot.start+i%(slot.end-slot.start+1)]++
+				missing--
+			}
+		}
+	}
+}
+
+// AddCells adds a row of cells to the table.
+//
+// Example:
+//
+//	table.AddCells(
+//	    colorbear.NewCell("Total").Colspan(2),
+//	    colorbear.NewCell("$45.00").Style(colorbear.NewStyle().Bold()),
+//	)
+func (t *Table) AddCells(cells ...*Cell) *Table {
+	row := tableRow{cells: make([]Cell, len(cells))}
+	for i, cell := range cells {
+		if cell != nil {
+			row.cells[i] = *cell
+		}
+	}
+	t.rows = append(t.rows, row)
+	t.columnWidths = []int{}
+	return t
+}
+
+// SetRowStyle sets the style of a whole row. Rows are counted from 0 in
+// the order they were added, separators included (see RowCount).
+//
+// The row style is applied on top of the alternating row colors; column
+// colors, rules and cell styles are applied on top of the row style.
+//
+// Example:
+//
+//	table.AddRow("db", "stopped")
+//	table.SetRowStyle(table.RowCount()-1, colorbear.NewStyle().Dim())
+func (t *Table) SetRowStyle(row int, style *Style) *Table {
+	if row >= 0 && row < len(t.rows) {
+		t.rows[row].style = style
+	}
+	return t
+}
+
+// Condition decides whether a formatting rule applies to a cell. It
+// receives the cell text as added (see AddRule).
+type Condition func(cell string) bool
+
+// formatRule styles the cells of a column that meet a condition.
+type formatRule struct {
+	column int
+	when   Condition
+	style  *Style
+}
+
+// AddRule adds a conditional formatting rule: cells of the column that
+// meet the condition get the style. Use a column of -1 to check all
+// columns.
+//
+// Conditions see the raw cell text (before Column.Format), and rules only
+// apply to data rows. When several rules match, all styles are applied in
+// the order the rules were added, so later rules win where they conflict.
+// Cell styles are applied after rules.
+//
+// Example:
+//
+//	table.AddRule(2, colorbear.IfNegative(), colorbear.NewStyle().Red())
+//	table.AddRule(3, colorbear.IfAbove(90), colorbear.NewStyle().Red().Bold())
+//	table.AddRule(3, colorbear.IfBetween(70, 90), colorbear.NewStyle().Yellow())
+//	table.AddRule(-1, colorbear.IfEquals("n/a"), colorbear.NewStyle().Dim())
+func (t *Table) AddRule(column int, when Condition, style *Style) *Table {
+	t.rules = append(t.rules, formatRule{column: column, when: when, style: style})
+	return t
+}
+
+// IfNegative matches cells holding a number below zero. Numbers are
+// parsed like CompareNumeric does ("-$3.50", "-12%").
+func IfNegative() Condition {
+	return IfBelow(0)
+}
+
+// IfAbove matches cells holding a number greater than n ("95%" is above 90).
+func IfAbove(n float64) Condition {
+	return func(cell string) bool {
+		value, ok := parseNumber(cell)
+		return ok && value > n
+	}
+}
+
+// IfBelow matches cells holding a number less than n.
+func IfBelow(n float64) Condition {
+	return func(cell string) bool {
+		value, ok := parseNumber(cell)
+		return ok && value < n
+	}
+}
+
+// IfBetween matches cells holding a number from low to high (inclusive).
+func IfBetween(low, high float64) Condition {
+	return func(cell string) bool {
+		value, ok := parseNumber(cell)
+		return ok && value >= low && value <= high
+	}
+}
+
+// IfEquals matches cells whose text (without color codes) equals text.
+func IfEquals(text string) Condition {
+	return func(cell string) bool {
+		return stripANSI(cell) == text
+	}
+}
+
+// IfContains matches cells whose text (without color codes) contains text.
+func IfContains(text string) Condition {
+	return func(cell string) bool {
+		return strings.Contains(stripANSI(cell), text)
+	}
+}
+
+// ruleColor returns the combined codes of all rules matching a cell.
+func (t *Table) ruleColor(col int, cell string) string {
+	color := ""
+	for _, rule := range t.rules {
+		if (rule.column == col || rule.column < 0) && rule.when != nil && rule.when(cell) {
+			color += styleCodes(rule.style)
+		}
+	}
+	return color
+}
+
+// styleCodes returns the ANSI codes of a style as one string.
+func styleCodes(style *Style) string {
+	if style == nil {
+		return ""
+	}
+	return strings.Join(style.codes, "")
+}
@@ -0,0 +1,124 @@
+package colorbear
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTableCellColspan(t *testing.T) {
+	table := NewTable()
+	table.SetHeaders("Name", "Qty", "Price")
+	table.AddRow("Coffee", "2", "$7.00")
+	table.AddCells(NewCell("Sold out").Colspan(2), NewCell("-"))
+	table.AddCells(NewCell("Total").Colspan(3).Align(AlignRight))
+
+	output := table.String()
+	expected := []string{
+		"│ Coffee │ 2   │ $7.00 │",
+		"│ Sold out     │ -     │",
+		"│                Total │",
+	}
+	for _, line := range expected {
+		if !strings.Contains(output, line) {
+			t.Errorf("Expected output to contain %q:\n%s", line, output)
+		}
+	}
+}
+
+func TestTableCellColspanWidens(t *testing.T) {
+	table := NewTable()
+	table.SetHeaders("A", "B")
+	table.AddCells(NewCell("spanning text").Colspan(2))
+	table.calculateColumnWidths()
+
+	// 13 characters over two columns with a gap of 3
+	if table.columnWidths[0]+table.columnWidths[1]+3 != 13 {
+		t.Errorf("Expected spanned columns to fit the text, got %v", table.columnWidths)
+	}
+}
+
+func TestTableRowTexts(t *testing.T) {
+	row := tableRow{cells: []Cell{*NewCell("a").Colspan(2), *NewCell("b")}}
+
+	if result := strings.Join(row.texts(), "|"); result != "a||b" {
+		t.Errorf("Expected 'a||b', got %q", result)
+	}
+	if row.text(1) != "" || row.text(2) != "b" || row.width() != 3 {
+		t.Errorf("Expected spanned column to be empty, got %q and %q", row.text(1), row.text(2))
+	}
+}
+
+func TestTableCellColors(t *testing.T) {
+	table := NewTable()
+	table.SetColumns(Column{Header: "Name"}, Column{Header: "Change", Color: BlueCode})
+	table.AddRule(1, IfNegative(), NewStyle().Red())
+	table.AddRule(-1, IfEquals("n/a"), NewStyle().Dim())
+
+	tests := []struct {
+		name     string
+		col      int
+		cell     Cell
+		expected string
+	}{
+		{"no rule", 1, Cell{text: "+3"}, BlueCode},
+		{"negative", 1, Cell{text: "-3.5%"}, BlueCode + RedCode},
+		{"other column", 0, Cell{text: "-3"}, ""},
+		{"any column", 0, Cell{text: "n/a"}, Dim},
+		{"cell style last", 1, Cell{text: "-1", style: NewStyle().Green()}, BlueCode + RedCode + GreenCode},
+	}
+
+	for _, tt := range tests {
+		if color := table.cellColor(bodyRow, tt.col, tt.cell, ""); color != tt.expected {
+			t.Errorf("%s: expected %q, got %q", tt.name, tt.expected, color)
+		}
+	}
+
+	if color := table.cellColor(footerRow, 1, Cell{text: "-3"}, ""); color != "" {
+		t.Errorf("Expected rules to skip the footer, got %q", color)
+	}
+}
+
+func TestConditions(t *testing.T) {
+	tests := []struct {
+		name     string
+		when     Condition
+		cell     string
+		expected bool
+	}{
+		{"negative", IfNegative(), "-$3.50", true},
+		{"not negative", IfNegative(), "0", false},
+		{"above", IfAbove(90), "95%", true},
+		{"not a number", IfAbove(90), "n/a", false},
+		{"below", IfBelow(10), "9.9", true},
+		{"between", IfBetween(70, 90), "\033[32m70%\033[0m", true},
+		{"equals", IfEquals("ok"), "\033[32mok\033[0m", true},
+		{"contains", IfContains("err"), "stderr", true},
+	}
+
+	for _, tt := range tests {
+		if result := tt.when(tt.cell); result != tt.expected {
+			t.Errorf("%s: expected %v for %q, got %v", tt.name, tt.expected, tt.cell, result)
+		}
+	}
+}
+
+func TestTableSetRowStyle(t *testing.T) {
+	table := NewTable(WithRowColors(CyanCode))
+	table.AddRow("a")
+	table.AddRow("b")
+	table.SetRowStyle(1, NewStyle().Bold())
+	table.SetRowStyle(5, NewStyle().Red()) // Ignored
+
+	if table.rows[0].style != nil || styleCodes(table.rows[1].style) != Bold {
+		t.Errorf("Expected only the second row to be styled")
+	}
+}
+
+func TestWrapColorContinuesAfterReset(t *testing.T) {
+	cell := "a " + RedCode + "b" + Reset + " c"
+	expected := GreenCode + "a " + RedCode + "b" + Reset + GreenCode + " c" + Reset
+
+	if result := wrapColor(cell, GreenCode); result != expected {
+		t.Errorf("Expected %q, got %q", expected, result)
+	}
+}
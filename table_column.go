@@ -0,0 +1,96 @@
+// table_column.go
+package colorbear
+
+// Column configures a single table column.
+//
+// Columns bundle the settings that TableOptions spreads over parallel
+// slices (Alignment, ColumnWidths, Overflow, ...) and add settings that
+// only make sense per column, such as a color or a value formatter.
+// Settings of a Column take precedence over the table-wide options.
+// Zero values mean "not set" (or left alignment, wrapping and priority 0).
+type Column struct {
+	Header      string                   // Header text
+	Align       Alignment                // Alignment of the cells
+	HeaderAlign Alignment                // Alignment of the header
+	Width       int                      // Fixed width (overrides auto-sizing)
+	MinWidth    int                      // Minimum width (also the limit when shrinking to fit)
+	MaxWidth    int                      // Maximum width; longer text wraps or is truncated
+	Overflow    Overflow                 // Wrap or truncate text wider than the column
+	Color       string                   // Color for the cells (applied over row colors)
+	Format      func(cell string) string // Formats cells and footer for display
+	Priority    int                      // Shrinking/hiding priority (see WithColumnPriorities)
+	Hidden      bool                     // Whether the column is left out of the table
+}
+
+// SetColumns configures all columns and sets the headers from them.
+//
+// Format is applied to data and footer cells when the table is rendered
+// and exported; the stored values stay unchanged, so sorting and
+// filtering still work on the raw values. Hidden columns are neither
+// drawn nor exported.
+//
+// Example:
+//
+//	table := colorbear.NewTable()
+//	table.SetColumns(
+//	    colorbear.Column{Header: "Name", MaxWidth: 20, Overflow: colorbear.OverflowTruncate},
+//	    colorbear.Column{
+//	        Header:      "Price",
+//	        Align:       colorbear.AlignRight,
+//	        HeaderAlign: colorbear.AlignRight,
+//	        Format:      func(cell string) string { return "$" + cell },
+//	    },
+//	    colorbear.Column{Header: "Internal ID", Hidden: true},
+//	)
+//	table.AddRow("Coffee", "3.50", "a1b2")
+func (t *Table) SetColumns(columns ...Column) *Table {
+	t.columns = columns
+	t.columnWidths = []int{}
+
+	headers := make([]string, len(columns))
+	for i, column := range columns {
+		headers[i] = column.Header
+	}
+	return t.SetHeaders(headers...)
+}
+
+// Columns returns the column configuration set with SetColumns.
+func (t *Table) Columns() []Column {
+	return append([]Column(nil), t.columns...)
+}
+
+// column returns the configuration of a column, or an empty Column if
+// none was set.
+func (t *Table) column(col int) Column {
+	if col < len(t.columns) {
+		return t.columns[col]
+	}
+	return Column{}
+}
+
+// getHeaderAlignment returns the alignment for a header cell. Without
+// column configuration, headers are aligned like their column.
+func (t *Table) getHeaderAlignment(col int) Alignment {
+	if col < len(t.columns) {
+		return t.columns[col].HeaderAlign
+	}
+	return t.getAlignment(col)
+}
+
+// applyFormat runs the column formatter on a data or footer cell.
+func (t *Table) applyFormat(col int, cell string) string {
+	if format := t.column(col).Format; format != nil {
+		return format(cell)
+	}
+	return cell
+}
+
+// cellColor returns the color for a cell. For data rows, the codes of the
+// row color, the column color, matching rules and the cell style are
+// combined in this order, so later ones win where they conflict.
+func (t *Table) cellColor(kind rowKind, col int, cell Cell, rowColor string) string {
+	if kind != bodyRow {
+		return rowColor + styleCodes(cell.style)
+	}
+	return rowColor + t.column(col).Color + t.ruleColor(col, cell.text) + styleCodes(cell.style)
+}
@@ -0,0 +1,116 @@
+package colorbear
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTableSetColumns(t *testing.T) {
+	table := NewTable()
+	table.SetColumns(
+		Column{Header: "Name"},
+		Column{Header: "Price", Align: AlignRight, Format: func(cell string) string { return "$" + cell }},
+		Column{Header: "ID", Hidden: true},
+	)
+	table.AddRow("Coffee", "3.50", "a1b2")
+	table.AddRow("Cake", "12.00", "c3d4")
+	table.SetFooter("Total", "15.50")
+
+	output := table.String()
+
+	expected := []string{
+		"│ Name   │ Price  │",
+		"│ Coffee │  $3.50 │",
+		"│ Cake   │ $12.00 │",
+		"│ Total  │ $15.50 │",
+	}
+	for _, line := range expected {
+		if !strings.Contains(output, line) {
+			t.Errorf("Expected output to contain %q:\n%s", line, output)
+		}
+	}
+	if strings.Contains(output, "a1b2") || strings.Contains(output, "ID") {
+		t.Errorf("Expected hidden column to be left out:\n%s", output)
+	}
+
+	// Stored values stay raw
+	if table.rows[0].text(1) != "3.50" {
+		t.Errorf("Expected raw value to be kept, got %q", table.rows[0].text(1))
+	}
+}
+
+func TestTableColumnHeaderAlign(t *testing.T) {
+	table := NewTable()
+	table.SetColumns(
+		Column{Header: "Qty", Align: AlignRight, HeaderAlign: AlignCenter, MinWidth: 7},
+	)
+	table.AddRow("5")
+
+	output := table.String()
+	if !strings.Contains(output, "│   Qty   │") || !strings.Contains(output, "│       5 │") {
+		t.Errorf("Expected centered header and right-aligned cells:\n%s", output)
+	}
+}
+
+func TestTableColumnWidths(t *testing.T) {
+	table := NewTable(WithMaxWidth(20))
+	table.SetColumns(
+		Column{Header: "Fixed", Width: 8},
+		Column{Header: "Max", MaxWidth: 6, Overflow: OverflowTruncate},
+		Column{Header: "Table-wide"},
+	)
+	table.AddRow("a", "truncate me", strings.Repeat("x", 30))
+	table.calculateColumnWidths()
+
+	expected := []int{8, 6, 20}
+	for i, width := range expected {
+		if table.columnWidths[i] != width {
+			t.Errorf("Expected width %d for column %d, got %d", width, i, table.columnWidths[i])
+		}
+	}
+	if output := table.String(); !strings.Contains(output, "trunc…") {
+		t.Errorf("Expected truncated column:\n%s", output)
+	}
+}
+
+func TestTableColumnColor(t *testing.T) {
+	table := NewTable()
+	table.SetColumns(Column{Header: "A"}, Column{Header: "B", Color: RedCode})
+
+	if color := table.cellColor(bodyRow, 1, Cell{}, GreenCode); color != GreenCode+RedCode {
+		t.Errorf("Expected column color after the row color, got %q", color)
+	}
+	if color := table.cellColor(bodyRow, 0, Cell{}, GreenCode); color != GreenCode {
+		t.Errorf("Expected row color without column color, got %q", color)
+	}
+	if color := table.cellColor(headerRow, 1, Cell{}, CyanCode); color != CyanCode {
+		t.Errorf("Expected header color for header cells, got %q", color)
+	}
+}
+
+func TestTableColumnFormatInExports(t *testing.T) {
+	table := NewTable()
+	table.SetColumns(Column{Header: "Size", Format: func(cell string) string { return cell + " KiB" }})
+	table.AddRow("12")
+
+	if csv := table.CSV(); !strings.Contains(csv, "12 KiB") {
+		t.Errorf("Expected formatted value in CSV, got %q", csv)
+	}
+}
+
+func TestTableVisibilityOptions(t *testing.T) {
+	table := NewTable(WithShowBorders(false), WithShowHeader(false), WithMinWidth(4))
+	table.SetHeaders("Name", "Age")
+	table.AddRow("Bo", "3")
+
+	output := table.String()
+	if strings.Contains(output, "Name") {
+		t.Errorf("Expected header to be hidden:\n%s", output)
+	}
+	if strings.ContainsAny(output, "│─╭") {
+		t.Errorf("Expected no borders:\n%s", output)
+	}
+	if output != " Bo    3    \n" {
+		t.Errorf("Expected minimum width 4 per column, got %q", output)
+	}
+}
@@ -0,0 +1,625 @@
+// table_export.go
+package colorbear
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+)
+
+// TableFormat selects the output format of Table.Render.
+type TableFormat int
+
+const (
+	TableFormatText     TableFormat = iota // Terminal table (same as String())
+	TableFormatMarkdown                    // GitHub-flavored Markdown
+	TableFormatCSV                         // Comma-separated values (RFC 4180)
+	TableFormatTSV                         // Tab-separated values
+	TableFormatJSON                        // JSON array of objects
+	TableFormatHTML                        // HTML table
+)
+
+// Render returns the table in the given format.
+//
+// The same table can be printed to the terminal, posted as a Markdown
+// comment or saved for a spreadsheet without building it twice.
+// Separator rows are left out of all formats except text. Hidden columns
+// (see Column.Hidden) are left out of all formats.
+//
+// Example:
+//
+//	table := colorbear.NewTable()
+//	table.SetHeaders("Name", "Status")
+//	table.AddRow("api", colorbear.TableSuccess("up"))
+//
+//	os.WriteFile("status.md", []byte(table.Render(colorbear.TableFormatMarkdown)), 0644)
+//	os.WriteFile("status.csv", []byte(table.Render(colorbear.TableFormatCSV)), 0644)
+func (t *Table) Render(format TableFormat) string {
+	switch format {
+	case TableFormatMarkdown:
+		return t.Markdown()
+	case TableFormatCSV:
+		return t.CSV()
+	case TableFormatTSV:
+		return t.TSV()
+	case TableFormatJSON:
+		return t.JSON()
+	case TableFormatHTML:
+		return t.HTML()
+	default:
+		return t.String()
+	}
+}
+
+// Markdown returns the table as a GitHub-flavored Markdown table.
+//
+// Column alignments set with WithAlignment or SetColumns become alignment
+// markers (":---", ":---:", "---:"). ANSI colors are removed, pipes are
+// escaped and line breaks become <br>. The footer is added as the last row.
+//
+// Example:
+//
+//	table := colorbear.NewTable(
+//	    colorbear.WithAlignment(colorbear.AlignLeft, colorbear.AlignRight),
+//	)
+//	table.SetHeaders("Name", "Size")
+//	table.AddRow("app.js", "12 KiB")
+//	fmt.Print(table.Markdown())
+//	// Output:
+//	// | Name   |   Size |
+//	// | :----- | -----: |
+//	// | app.js | 12 KiB |
+func (t *Table) Markdown() string {
+	numCols := t.determineColumnCount()
+	if numCols == 0 {
+		return ""
+	}
+
+	cols := t.exportColumns(numCols)
+	if len(cols) == 0 {
+		return ""
+	}
+
+	rows := [][]string{t.exportHeaders(numCols)}
+	rows = append(rows, t.exportRows(numCols)...)
+	if footer := t.footerCells(); len(footer) > 0 {
+		rows = append(rows, t.exportCells(footer, numCols))
+	}
+
+	// Escape cells and measure columns (markers need at least 3 dashes)
+	widths := make([]int, len(cols))
+	for i := range widths {
+		widths[i] = 3
+	}
+	for _, row := range rows {
+		for i, cell := range row {
+			row[i] = markdownEscape(cell)
+			widths[i] = maxInt(widths[i], visualWidth(row[i]))
+		}
+	}
+
+	var output strings.Builder
+	for i, row := range rows {
+		output.WriteString(t.markdownRow(row, widths, cols))
+		if i == 0 {
+			output.WriteString(t.markdownMarkers(widths, cols))
+		}
+	}
+	return output.String()
+}
+
+// markdownRow writes one row padded to the column widths. cols holds the
+// table column of every cell.
+func (t *Table) markdownRow(cells []string, widths, cols []int) string {
+	parts := make([]string, len(cells))
+	for i, cell := range cells {
+		parts[i] = t.alignText(cell, widths[i], t.getAlignment(cols[i]))
+	}
+	return "| " + strings.Join(parts, " | ") + " |\n"
+}
+
+// markdownMarkers writes the delimiter row with alignment markers.
+// Columns without an explicit alignment get plain dashes.
+func (t *Table) markdownMarkers(widths, cols []int) string {
+	parts := make([]string, len(widths))
+	for i, width := range widths {
+		col := cols[i]
+		switch {
+		case col >= len(t.columns) && col >= len(t.options.Alignment):
+			parts[i] = strings.Repeat("-", width)
+		case t.getAlignment(col) == AlignRight:
+			parts[i] = strings.Repeat("-", width-1) + ":"
+		case t.getAlignment(col) == AlignCenter:
+			parts[i] = ":" + strings.Repeat("-", width-2) + ":"
+		default:
+			parts[i] = ":" + strings.Repeat("-", width-1)
+		}
+	}
+	return "| " + strings.Join(parts, " | ") + " |\n"
+}
+
+// markdownEscape makes cell text safe for a Markdown table.
+func markdownEscape(cell string) string {
+	cell = stripANSI(cell)
+	cell = strings.ReplaceAll(cell, "|", `\|`)
+	cell = strings.ReplaceAll(cell, "\r\n", "<br>")
+	return strings.ReplaceAll(cell, "\n", "<br>")
+}
+
+// CSV returns the table as comma-separated values following RFC 4180.
+//
+// Cells containing commas, quotes or line breaks are quoted, lines end
+// with CRLF. ANSI colors are removed and hidden columns are left out. The
+// header is the first record and the footer, if set, the last one.
+//
+// Example:
+//
+//	os.WriteFile("report.csv", []byte(table.CSV()), 0644)
+func (t *Table) CSV() string {
+	numCols := t.determineColumnCount()
+	if len(t.exportColumns(numCols)) == 0 {
+		return ""
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	writer.UseCRLF = true
+
+	for _, record := range t.exportRecords(numCols) {
+		for i, cell := range record {
+			record[i] = stripANSI(cell)
+		}
+		writer.Write(record) // Writes to a bytes.Buffer cannot fail
+	}
+	writer.Flush()
+	return buf.String()
+}
+
+// TSV returns the table as tab-separated values.
+//
+// TSV has no quoting, so tabs and line breaks inside cells are replaced
+// with spaces. ANSI colors are removed and hidden columns are left out.
+// The header is the first line and the footer, if set, the last one.
+//
+// Example:
+//
+//	fmt.Print(table.TSV()) // Paste into a spreadsheet
+func (t *Table) TSV() string {
+	numCols := t.determineColumnCount()
+	if len(t.exportColumns(numCols)) == 0 {
+		return ""
+	}
+
+	replacer := strings.NewReplacer("\t", " ", "\r\n", " ", "\n", " ", "\r", " ")
+
+	var output strings.Builder
+	for _, record := range t.exportRecords(numCols) {
+		for i, cell := range record {
+			record[i] = replacer.Replace(stripANSI(cell))
+		}
+		output.WriteString(strings.Join(record, "\t"))
+		output.WriteString("\n")
+	}
+	return output.String()
+}
+
+// JSON returns the rows as a JSON array of objects.
+//
+// Every row becomes an object whose keys are the headers, in header
+// order. Columns without a header use "column1", "column2", and so on;
+// repeated headers get a suffix ("Name", "Name_2"), so every key is
+// unique. ANSI colors are removed; hidden columns and the footer are not
+// included.
+//
+// Example:
+//
+//	fmt.Println(table.JSON())
+//	// Output:
+//	// [
+//	//   {"Name": "Alice", "Age": "28"},
+//	//   {"Name": "Bob", "Age": "34"}
+//	// ]
+func (t *Table) JSON() string {
+	numCols := t.determineColumnCount()
+	cols := t.exportColumns(numCols)
+	rows := t.exportRows(numCols)
+	if len(rows) == 0 || len(cols) == 0 {
+		return "[]"
+	}
+
+	keys := t.jsonKeys(cols)
+
+	var output strings.Builder
+	output.WriteString("[\n")
+	for r, row := range rows {
+		fields := make([]string, len(cols))
+		for i, cell := range row {
+			fields[i] = jsonString(keys[i]) + ": " + jsonString(stripANSI(cell))
+		}
+		output.WriteString("  {" + strings.Join(fields, ", ") + "}")
+		if r < len(rows)-1 {
+			output.WriteString(",")
+		}
+		output.WriteString("\n")
+	}
+	output.WriteString("]")
+	return output.String()
+}
+
+// jsonKeys returns a unique object key for every exported column: its
+// header, or "columnN" for columns without one. Keys that were used
+// before get the first free suffix ("_2", "_3", ...).
+func (t *Table) jsonKeys(cols []int) []string {
+	keys := make([]string, len(cols))
+	used := map[string]bool{}
+	for i, col := range cols {
+		key := ""
+		if col < len(t.headers) {
+			key = strings.TrimSpace(stripANSI(t.headers[col]))
+		}
+		if key == "" {
+			key = "column" + strconv.Itoa(col+1)
+		}
+
+		unique := key
+		for n := 2; used[unique]; n++ {
+			unique = key + "_" + strconv.Itoa(n)
+		}
+		used[unique] = true
+		keys[i] = unique
+	}
+	return keys
+}
+
+// jsonString encodes a string as a JSON string literal without escaping
+// HTML characters (so "<" stays readable).
+func jsonString(s string) string {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.Encode(s) // Encoding a string cannot fail
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// HTML returns the table as an HTML <table> element.
+//
+// ANSI colors and text styles inside cells are translated to <span>
+// elements with inline CSS, so colored status cells keep their colors.
+// Column alignments become text-align styles and hidden columns are left
+// out. The header, rows and footer are placed in <thead>, <tbody> and
+// <tfoot>.
+//
+// Example:
+//
+//	table.AddRow("api", colorbear.TableSuccess("up"))
+//	fmt.Println(table.HTML())
+//	// ... <td><span style="color: #00cd00">[OK] up</span></td> ...
+func (t *Table) HTML() string {
+	numCols := t.determineColumnCount()
+	cols := t.exportColumns(numCols)
+	if len(cols) == 0 {
+		return ""
+	}
+
+	var output strings.Builder
+	output.WriteString("<table>\n")
+	if t.title != "" {
+		fmt.Fprintf(&output, "  <caption>%s</caption>\n", ansiToHTML(singleLine(t.title)))
+	}
+
+	if len(t.headers) > 0 {
+		output.WriteString("  <thead>\n")
+		t.writeHTMLRow(&output, "th", t.exportHeaders(numCols), cols)
+		output.WriteString("  </thead>\n")
+	}
+
+	output.WriteString("  <tbody>\n")
+	for _, row := range t.exportRows(numCols) {
+		t.writeHTMLRow(&output, "td", row, cols)
+	}
+	output.WriteString("  </tbody>\n")
+
+	if footer := t.footerCells(); len(footer) > 0 {
+		output.WriteString("  <tfoot>\n")
+		t.writeHTMLRow(&output, "td", t.exportCells(footer, numCols), cols)
+		output.WriteString("  </tfoot>\n")
+	}
+
+	output.WriteString("</table>\n")
+	return output.String()
+}
+
+// writeHTMLRow writes one <tr> with cells of the given tag (th or td).
+// cols holds the table column of every cell.
+func (t *Table) writeHTMLRow(output *strings.Builder, tag string, cells []string, cols []int) {
+	output.WriteString("    <tr>")
+	align := t.getAlignment
+	if tag == "th" {
+		align = t.getHeaderAlignment
+	}
+
+	for i, cell := range cells {
+		style := ""
+		switch align(cols[i]) {
+		case AlignCenter:
+			style = ` style="text-align: center"`
+		case AlignRight:
+			style = ` style="text-align: right"`
+		}
+		fmt.Fprintf(output, "<%s%s>%s</%s>", tag, style, ansiToHTML(cell), tag)
+	}
+	output.WriteString("</tr>\n")
+}
+
+// exportRows returns the formatted data rows without separators, each
+// with one cell per exported column. Cells spanning several columns or rows are
+// exported in their first column and row, the cells they cover are empty.
+func (t *Table) exportRows(numCols int) [][]string {
+	rows := [][]string{}
+	for _, slots := range t.placeRows(numCols) {
+		if slots == nil {
+			continue
+		}
+		cells := make([]string, numCols)
+		for _, slot := range slots {
+			if !slot.continued {
+				cells[slot.start] = slot.cell.text
+			}
+		}
+		rows = append(rows, t.exportCells(cells, numCols))
+	}
+	return rows
+}
+
+// exportRecords returns the header (if set), the data rows and the footer
+// (if set), each with one cell per exported column.
+func (t *Table) exportRecords(numCols int) [][]string {
+	records := [][]string{}
+	if len(t.headers) > 0 {
+		records = append(records, t.exportHeaders(numCols))
+	}
+	records = append(records, t.exportRows(numCols)...)
+	if footer := t.footerCells(); len(footer) > 0 {
+		records = append(records, t.exportCells(footer, numCols))
+	}
+	return records
+}
+
+// exportHeaders returns the headers of the exported columns.
+func (t *Table) exportHeaders(numCols int) []string {
+	return t.exportedOnly(fitCells(t.headers, numCols))
+}
+
+// exportCells fits data or footer cells to numCols cells, applies the
+// column formatters (see Column.Format) and drops hidden columns.
+func (t *Table) exportCells(cells []string, numCols int) []string {
+	fitted := fitCells(cells, numCols)
+	for i, cell := range fitted {
+		fitted[i] = t.applyFormat(i, cell)
+	}
+	return t.exportedOnly(fitted)
+}
+
+// exportColumns returns the indices of the columns that are exported:
+// all columns except hidden ones.
+func (t *Table) exportColumns(numCols int) []int {
+	cols := []int{}
+	for col := 0; col < numCols; col++ {
+		if !t.column(col).Hidden {
+			cols = append(cols, col)
+		}
+	}
+	return cols
+}
+
+// exportedOnly returns the cells of the exported columns from a row with
+// exactly one cell per table column.
+func (t *Table) exportedOnly(cells []string) []string {
+	exported := []string{}
+	for _, col := range t.exportColumns(len(cells)) {
+		exported = append(exported, cells[col])
+	}
+	return exported
+}
+
+// fitCells returns a copy of cells padded with empty cells or cut to
+// exactly numCols cells.
+func fitCells(cells []string, numCols int) []string {
+	fitted := make([]string, numCols)
+	copy(fitted, cells)
+	return fitted
+}
+
+// ansiPalette holds the 16 standard terminal colors (xterm defaults).
+var ansiPalette = [16]string{
+	"#000000", "#cd0000", "#00cd00", "#cdcd00", "#0000ee", "#cd00cd", "#00cdcd", "#e5e5e5",
+	"#7f7f7f", "#ff0000", "#00ff00", "#ffff00", "#5c5cff", "#ff00ff", "#00ffff", "#ffffff",
+}
+
+// ansiState tracks the active SGR attributes while translating to HTML.
+type ansiState struct {
+	fg, bg                                       string
+	bold, dim, italic, underline, strike, hidden bool
+}
+
+// ansiToHTML escapes text for HTML and translates ANSI SGR sequences
+// (colors and text styles) into <span> elements with inline CSS.
+// Other escape sequences are dropped.
+func ansiToHTML(text string) string {
+	var output strings.Builder
+	state := ansiState{}
+
+	for len(text) > 0 {
+		start := strings.Index(text, "\033[")
+		if start < 0 {
+			state.write(&output, text)
+			break
+		}
+		state.write(&output, text[:start])
+		text = text[start+2:]
+
+		// Parameters and intermediates run until the final byte (@ to ~)
+		end := strings.IndexFunc(text, func(r rune) bool { return r >= '@' && r <= '~' })
+		if end < 0 {
+			break // Incomplete sequence at the end
+		}
+		if text[end] == 'm' {
+			state.apply(text[:end])
+		}
+		text = text[end+1:]
+	}
+	return output.String()
+}
+
+// write appends escaped text, wrapped in a span if any style is active.
+func (s *ansiState) write(output *strings.Builder, text string) {
+	if text == "" {
+		return
+	}
+	escaped := strings.ReplaceAll(html.EscapeString(text), "\n", "<br>")
+
+	css := s.css()
+	if css == "" {
+		output.WriteString(escaped)
+		return
+	}
+	fmt.Fprintf(output, `<span style="%s">%s</span>`, css, escaped)
+}
+
+// apply updates the state from the parameters of an SGR sequence
+// (e.g., "1;31" or "38;2;255;0;0").
+func (s *ansiState) apply(params string) {
+	codes := []int{}
+	for _, part := range strings.Split(params, ";") {
+		code, _ := strconv.Atoi(part) // Empty parameters mean 0
+		codes = append(codes, code)
+	}
+
+	for i := 0; i < len(codes); i++ {
+		code := codes[i]
+		switch {
+		case code == 0:
+			*s = ansiState{}
+		case code == 1:
+			s.bold = true
+		case code == 2:
+			s.dim = true
+		case code == 3:
+			s.italic = true
+		case code == 4:
+			s.underline = true
+		case code == 8:
+			s.hidden = true
+		case code == 9:
+			s.strike = true
+		case code == 22:
+			s.bold, s.dim = false, false
+		case code == 23:
+			s.italic = false
+		case code == 24:
+			s.underline = false
+		case code == 28:
+			s.hidden = false
+		case code == 29:
+			s.strike = false
+		case code >= 30 && code <= 37:
+			s.fg = ansiPalette[code-30]
+		case code >= 90 && code <= 97:
+			s.fg = ansiPalette[code-90+8]
+		case code == 39:
+			s.fg = ""
+		case code >= 40 && code <= 47:
+			s.bg = ansiPalette[code-40]
+		case code >= 100 && code <= 107:
+			s.bg = ansiPalette[code-100+8]
+		case code == 49:
+			s.bg = ""
+		case code == 38 || code == 48:
+			color, used := extendedColor(codes[i+1:])
+			if code == 38 {
+				s.fg = color
+			} else {
+				s.bg = color
+			}
+			i += used
+		}
+	}
+}
+
+// extendedColor parses the arguments of a 38 or 48 SGR code:
+// "5;n" for the 256-color palette or "2;r;g;b" for 24-bit colors.
+// It returns the CSS color and the number of arguments consumed.
+func extendedColor(args []int) (string, int) {
+	switch {
+	case len(args) >= 2 && args[0] == 5:
+		return palette256(args[1]), 2
+	case len(args) >= 4 && args[0] == 2:
+		return fmt.Sprintf("#%02x%02x%02x", clampByte(args[1]), clampByte(args[2]), clampByte(args[3])), 4
+	default:
+		return "", len(args)
+	}
+}
+
+// palette256 returns the CSS color for an index of the 256-color palette.
+func palette256(n int) string {
+	switch {
+	case n < 0 || n > 255:
+		return ""
+	case n < 16:
+		return ansiPalette[n]
+	case n < 232:
+		// 6x6x6 color cube
+		levels := [6]int{0, 95, 135, 175, 215, 255}
+		n -= 16
+		return fmt.Sprintf("#%02x%02x%02x", levels[n/36], levels[n/6%6], levels[n%6])
+	default:
+		// Grayscale ramp
+		gray := 8 + (n-232)*10
+		return fmt.Sprintf("#%02x%02x%02x", gray, gray, gray)
+	}
+}
+
+// clampByte limits a color component to 0-255.
+func clampByte(n int) int {
+	return maxInt(0, minInt(255, n))
+}
+
+// css returns the inline style for the current state.
+func (s *ansiState) css() string {
+	styles := []string{}
+	if s.fg != "" {
+		styles = append(styles, "color: "+s.fg)
+	}
+	if s.bg != "" {
+		styles = append(styles, "background-color: "+s.bg)
+	}
+	if s.bold {
+		styles = append(styles, "font-weight: bold")
+	}
+	if s.dim {
+		styles = append(styles, "opacity: 0.7")
+	}
+	if s.italic {
+		styles = append(styles, "font-style: italic")
+	}
+	if s.hidden {
+		styles = append(styles, "visibility: hidden")
+	}
+
+	decorations := []string{}
+	if s.underline {
+		decorations = append(decorations, "underline")
+	}
+	if s.strike {
+		decorations = append(decorations, "line-through")
+	}
+	if len(decorations) > 0 {
+		styles = append(styles, "text-decoration: "+strings.Join(decorations, " "))
+	}
+
+	return strings.Join(styles, "; ")
+}
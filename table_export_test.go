@@ -0,0 +1,254 @@
+package colorbear
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func newExportTable() *Table {
+	table := NewTable(WithAlignment(AlignLeft, AlignRight, AlignCenter))
+	table.SetHeaders("Name", "Size", "Note")
+	table.AddRow("app.js", "12 KiB", "a|b")
+	table.AddSeparator()
+	table.AddRow("\033[31mlog, \"old\"\033[0m", "3 B", "line1\nline2")
+	table.SetFooter("Total", "12 KiB")
+	return table
+}
+
+func TestTableMarkdown(t *testing.T) {
+	output := newExportTable().Markdown()
+	lines := strings.Split(strings.TrimSuffix(output, "\n"), "\n")
+
+	if len(lines) != 5 {
+		t.Fatalf("Expected 5 lines (header, markers, 2 rows, footer), got %d:\n%s", len(lines), output)
+	}
+	if lines[1] != "| :--------- | -----: | :------------: |" {
+		t.Errorf("Expected alignment markers, got %q", lines[1])
+	}
+	if !strings.Contains(lines[2], `a\|b`) {
+		t.Errorf("Expected escaped pipe, got %q", lines[2])
+	}
+	if !strings.Contains(lines[3], "line1<br>line2") || strings.Contains(lines[3], "\033") {
+		t.Errorf("Expected <br> and no ANSI codes, got %q", lines[3])
+	}
+	if !strings.HasPrefix(lines[4], "| Total") {
+		t.Errorf("Expected footer as last row, got %q", lines[4])
+	}
+}
+
+func TestTableMarkdownDefaultAlignment(t *testing.T) {
+	table := NewTable()
+	table.SetHeaders("A", "B")
+	table.AddRow("1", "2")
+
+	lines := strings.Split(table.Markdown(), "\n")
+	if lines[1] != "| --- | --- |" {
+		t.Errorf("Expected plain markers without alignment, got %q", lines[1])
+	}
+}
+
+func TestTableMarkdownColumnAlignment(t *testing.T) {
+	table := NewTable()
+	table.SetColumns(
+		Column{Header: "Name"},
+		Column{Header: "Size", Align: AlignRight},
+		Column{Header: "Note", Align: AlignCenter},
+	)
+	table.AddRow("app.js", "12 KiB", "ok")
+
+	lines := strings.Split(table.Markdown(), "\n")
+	if lines[1] != "| :----- | -----: | :--: |" {
+		t.Errorf("Expected markers from the column alignments, got %q", lines[1])
+	}
+	if lines[2] != "| app.js | 12 KiB |  ok  |" {
+		t.Errorf("Expected cells aligned like their columns, got %q", lines[2])
+	}
+}
+
+func TestTableExportsSkipHiddenColumns(t *testing.T) {
+	table := NewTable()
+	table.SetColumns(
+		Column{Header: "Name"},
+		Column{Header: "ID", Hidden: true},
+		Column{Header: "Price", Align: AlignRight},
+	)
+	table.AddRow("Coffee", "a1b2", "3.50")
+	table.SetFooter("Total", "x", "3.50")
+
+	exports := map[string]string{
+		"Markdown": table.Markdown(),
+		"CSV":      table.CSV(),
+		"TSV":      table.TSV(),
+		"JSON":     table.JSON(),
+		"HTML":     table.HTML(),
+	}
+	for name, output := range exports {
+		if strings.Contains(output, "ID") || strings.Contains(output, "a1b2") {
+			t.Errorf("%s export should not contain the hidden column:\n%s", name, output)
+		}
+		if !strings.Contains(output, "Price") || !strings.Contains(output, "3.50") {
+			t.Errorf("%s export should contain the visible columns:\n%s", name, output)
+		}
+	}
+
+	if lines := strings.Split(table.Markdown(), "\n"); lines[1] != "| :----- | ----: |" {
+		t.Errorf("Expected markers of the visible columns, got %q", lines[1])
+	}
+	if expected := "Name,Price\r\nCoffee,3.50\r\nTotal,3.50\r\n"; table.CSV() != expected {
+		t.Errorf("Expected %q, got %q", expected, table.CSV())
+	}
+	if !strings.Contains(table.HTML(), `<td style="text-align: right">3.50</td>`) {
+		t.Errorf("Expected the price column to keep its alignment:\n%s", table.HTML())
+	}
+}
+
+func TestTableCSV(t *testing.T) {
+	output := newExportTable().CSV()
+
+	if !strings.Contains(output, "\r\n") {
+		t.Error("Expected CRLF line endings")
+	}
+
+	records, err := csv.NewReader(strings.NewReader(output)).ReadAll()
+	if err != nil {
+		t.Fatalf("Expected valid CSV, got %v", err)
+	}
+	if len(records) != 4 {
+		t.Fatalf("Expected 4 records, got %d", len(records))
+	}
+	if records[2][0] != `log, "old"` {
+		t.Errorf("Expected quoted cell without ANSI, got %q", records[2][0])
+	}
+	if records[2][2] != "line1\nline2" {
+		t.Errorf("Expected line break to survive quoting, got %q", records[2][2])
+	}
+	if records[3][2] != "" {
+		t.Errorf("Expected short footer to be padded, got %v", records[3])
+	}
+}
+
+func TestTableTSV(t *testing.T) {
+	table := NewTable()
+	table.SetHeaders("A", "B")
+	table.AddRow("x\ty", "line1\nline2")
+
+	expected := "A\tB\nx y\tline1 line2\n"
+	if output := table.TSV(); output != expected {
+		t.Errorf("Expected %q, got %q", expected, output)
+	}
+}
+
+func TestTableJSON(t *testing.T) {
+	table := newExportTable()
+	output := table.JSON()
+
+	var rows []map[string]string
+	if err := json.Unmarshal([]byte(output), &rows); err != nil {
+		t.Fatalf("Expected valid JSON, got %v:\n%s", err, output)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("Expected 2 rows (no separator, no footer), got %d", len(rows))
+	}
+	if rows[1]["Name"] != `log, "old"` || rows[0]["Note"] != "a|b" {
+		t.Errorf("Unexpected values: %v", rows)
+	}
+
+	// Keys follow header order
+	first := strings.Split(output, "\n")[1]
+	if strings.Index(first, `"Name"`) > strings.Index(first, `"Size"`) {
+		t.Errorf("Expected keys in header order, got %s", first)
+	}
+}
+
+func TestTableJSONWithoutHeaders(t *testing.T) {
+	table := NewTable()
+	table.AddRow("a", "<b>")
+
+	expected := "[\n  {\"column1\": \"a\", \"column2\": \"<b>\"}\n]"
+	if output := table.JSON(); output != expected {
+		t.Errorf("Expected %q, got %q", expected, output)
+	}
+
+	if output := NewTable().JSON(); output != "[]" {
+		t.Errorf("Expected '[]' for empty table, got %q", output)
+	}
+}
+
+func TestTableHTML(t *testing.T) {
+	output := newExportTable().HTML()
+
+	expected := []string{
+		"<thead>",
+		"<th>Name</th>",
+		`<th style="text-align: right">Size</th>`,
+		`<td style="text-align: center">a|b</td>`,
+		`<span style="color: #cd0000">log, &#34;old&#34;</span>`,
+		"line1<br>line2",
+		"<tfoot>",
+	}
+	for _, want := range expected {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected HTML to contain %q:\n%s", want, output)
+		}
+	}
+}
+
+func TestAnsiToHTML(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"plain <b>", "plain &lt;b&gt;"},
+		{"\033[1;32mok\033[0m", `<span style="color: #00cd00; font-weight: bold">ok</span>`},
+		{"\033[38;2;255;128;0mx", `<span style="color: #ff8000">x</span>`},
+		{"\033[48;5;196mx", `<span style="background-color: #ff0000">x</span>`},
+		{"\033[4m\033[9mx\033[24my", `<span style="text-decoration: underline line-through">x</span><span style="text-decoration: line-through">y</span>`},
+		{"a\033[2Kb", "ab"},
+	}
+
+	for _, tt := range tests {
+		if result := ansiToHTML(tt.input); result != tt.expected {
+			t.Errorf("ansiToHTML(%q): expected %q, got %q", tt.input, tt.expected, result)
+		}
+	}
+}
+
+func TestTableRender(t *testing.T) {
+	table := newExportTable()
+
+	formats := map[TableFormat]string{
+		TableFormatText:     table.String(),
+		TableFormatMarkdown: table.Markdown(),
+		TableFormatCSV:      table.CSV(),
+		TableFormatTSV:      table.TSV(),
+		TableFormatJSON:     table.JSON(),
+		TableFormatHTML:     table.HTML(),
+	}
+	for format, expected := range formats {
+		if output := table.Render(format); output != expected {
+			t.Errorf("Render(%d) does not match the dedicated method", format)
+		}
+	}
+}
+
+func TestTableJSONUniqueKeys(t *testing.T) {
+	table := NewTable()
+	table.SetHeaders("Name", "Name", "", "column3", "Name_2")
+	table.AddRow("a", "b", "c", "d", "e")
+
+	output := table.JSON()
+	expected := "[\n  {\"Name\": \"a\", \"Name_2\": \"b\", \"column3\": \"c\", \"column3_2\": \"d\", \"Name_2_2\": \"e\"}\n]"
+	if output != expected {
+		t.Errorf("Expected %q, got %q", expected, output)
+	}
+
+	var rows []map[string]string
+	if err := json.Unmarshal([]byte(output), &rows); err != nil {
+		t.Fatalf("Expected valid JSON, got %v", err)
+	}
+	if len(rows) != 1 || len(rows[0]) != 5 {
+		t.Errorf("Expected 5 distinct keys, got %v", rows)
+	}
+}
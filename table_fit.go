@@ -0,0 +1,245 @@
+// table_fit.go
+package colorbear
+
+// defaultMinFitWidth is the narrowest a column is shrunk to when fitting
+// the table width, unless a minimum is configured.
+const defaultMinFitWidth = 5
+
+// WithMaxTableWidth limits the total width of the table, including
+// borders and padding.
+//
+// If the table is wider, columns are shrunk until it fits: columns with
+// the lowest priority first (see WithColumnPriorities), and among those
+// the widest first. Text in shrunk columns is wrapped or truncated (see
+// WithOverflow). Columns are never shrunk below their minimum width
+// (see WithColumnMinWidths).
+//
+// Example:
+//
+//	table := colorbear.NewTable(colorbear.WithMaxTableWidth(80))
+func WithMaxTableWidth(width int) TableOption {
+	return func(o *TableOptions) {
+		o.MaxTableWidth = width
+	}
+}
+
+// WithFitToTerminal limits the table width to the width of the terminal.
+//
+// It works like WithMaxTableWidth with the current terminal width. When
+// stdout is not a terminal, the COLUMNS environment variable is used; if
+// that is not set either, the table is not limited.
+//
+// Example:
+//
+//	table := colorbear.NewTable(
+//	    colorbear.WithFitToTerminal(true),
+//	    colorbear.WithOverflow(colorbear.OverflowWrap, colorbear.OverflowTruncate),
+//	)
+func WithFitToTerminal(enabled bool) TableOption {
+	return func(o *TableOptions) {
+		o.FitToTerminal = enabled
+	}
+}
+
+// WithColumnMinWidths sets the minimum width per column when the table is
+// shrunk to fit. Columns without a minimum (or a minimum of 0) use
+// MinWidth if set, or 5 otherwise.
+//
+// Example:
+//
+//	// Never shrink the ID column, let the description get narrow
+//	colorbear.WithColumnMinWidths(36, 0, 10)
+func WithColumnMinWidths(widths ...int) TableOption {
+	return func(o *TableOptions) {
+		o.ColumnMinWidths = widths
+	}
+}
+
+// WithColumnPriorities sets the priority of each column when the table is
+// shrunk to fit. Columns with a lower priority are shrunk (and hidden, see
+// WithHideColumns) first. Columns without a priority have priority 0.
+//
+// Example:
+//
+//	// Name is most important, Notes least
+//	colorbear.WithColumnPriorities(10, 5, 0)
+func WithColumnPriorities(priorities ...int) TableOption {
+	return func(o *TableOptions) {
+		o.ColumnPriorities = priorities
+	}
+}
+
+// WithHideColumns hides columns when the table does not fit even with all
+// columns at their minimum width.
+//
+// Columns are hidden by priority, lowest first (the rightmost of equal
+// priority first), until the rest fits. At least one column is always
+// shown.
+//
+// Example:
+//
+//	table := colorbear.NewTable(
+//	    colorbear.WithFitToTerminal(true),
+//	    colorbear.WithColumnPriorities(10, 5, 1, 0),
+//	    colorbear.WithHideColumns(true),
+//	)
+func WithHideColumns(enabled bool) TableOption {
+	return func(o *TableOptions) {
+		o.HideColumns = enabled
+	}
+}
+
+// fitToWidth shrinks and hides columns until the table fits the width
+// limit. It starts from the columns hidden in their configuration.
+func (t *Table) fitToWidth() {
+	t.hidden = make([]bool, len(t.columnWidths))
+	for i := range t.hidden {
+		t.hidden[i] = t.column(i).Hidden
+	}
+
+	limit := t.widthLimit()
+	if limit <= 0 {
+		return
+	}
+
+	if t.options.HideColumns {
+		for t.visibleColumnCount() > 1 && t.minTableWidth() > limit {
+			t.hidden[t.hideCandidate()] = true
+		}
+	}
+
+	for t.tableWidth() > limit {
+		col := t.shrinkCandidate()
+		if col < 0 {
+			return // All columns are at their minimum
+		}
+		t.columnWidths[col]--
+	}
+}
+
+// widthLimit returns the maximum table width, or 0 if there is none.
+func (t *Table) widthLimit() int {
+	limit := t.options.MaxTableWidth
+	if t.options.FitToTerminal {
+		if width := terminalWidth(t.outputWriter()); width > 0 && (limit <= 0 || width < limit) {
+			limit = width
+		}
+	}
+	return limit
+}
+
+// tableWidth returns the total width of the visible columns including
+// padding and borders.
+func (t *Table) tableWidth() int {
+	width := 0
+	for i, w := range t.columnWidths {
+		if !t.isHidden(i) {
+			width += w
+		}
+	}
+	return width + t.tableOverhead()
+}
+
+// minTableWidth returns the table width with all visible columns at their
+// minimum width.
+func (t *Table) minTableWidth() int {
+	width := 0
+	for i, w := range t.columnWidths {
+		if !t.isHidden(i) {
+			width += minInt(w, t.minColumnWidth(i))
+		}
+	}
+	return width + t.tableOverhead()
+}
+
+// tableOverhead returns the width used by padding and vertical borders.
+func (t *Table) tableOverhead() int {
+	n := t.visibleColumnCount()
+	overhead := n * 2 * t.options.Padding
+	overhead += maxInt(0, n-1) * t.verticalWidth(BorderInnerVertical)
+	return overhead + t.verticalWidth(BorderLeft) + t.verticalWidth(BorderRight)
+}
+
+// minColumnWidth returns the minimum width of a column when shrinking.
+func (t *Table) minColumnWidth(col int) int {
+	if width := t.column(col).MinWidth; width > 0 {
+		return width
+	}
+	if col < len(t.options.ColumnMinWidths) && t.options.ColumnMinWidths[col] > 0 {
+		return t.options.ColumnMinWidths[col]
+	}
+	if t.options.MinWidth > 0 {
+		return t.options.MinWidth
+	}
+	return defaultMinFitWidth
+}
+
+// columnPriority returns the priority of a column (default 0).
+func (t *Table) columnPriority(col int) int {
+	if col < len(t.columns) {
+		return t.columns[col].Priority
+	}
+	if col < len(t.options.ColumnPriorities) {
+		return t.options.ColumnPriorities[col]
+	}
+	return 0
+}
+
+// shrinkCandidate returns the column to shrink next: the widest column of
+// the lowest priority that is still above its minimum, or -1 if none is.
+func (t *Table) shrinkCandidate() int {
+	best := -1
+	for i, width := range t.columnWidths {
+		if t.isHidden(i) || width <= t.minColumnWidth(i) {
+			continue
+		}
+		if best < 0 ||
+			t.columnPriority(i) < t.columnPriority(best) ||
+			(t.columnPriority(i) == t.columnPriority(best) && width >= t.columnWidths[best]) {
+			best = i
+		}
+	}
+	return best
+}
+
+// hideCandidate returns the column to hide next: the rightmost visible
+// column of the lowest priority.
+func (t *Table) hideCandidate() int {
+	best := -1
+	for i := range t.columnWidths {
+		if t.isHidden(i) {
+			continue
+		}
+		if best < 0 || t.columnPriority(i) <= t.columnPriority(best) {
+			best = i
+		}
+	}
+	return best
+}
+
+// isHidden reports whether a column is hidden.
+func (t *Table) isHidden(col int) bool {
+	return col < len(t.hidden) && t.hidden[col]
+}
+
+// visibleColumnCount returns the number of columns that are not hidden.
+func (t *Table) visibleColumnCount() int {
+	count := 0
+	for i := range t.columnWidths {
+		if !t.isHidden(i) {
+			count++
+		}
+	}
+	return count
+}
+
+// lastVisibleColumn returns the index of the last column that is not
+// hidden, or -1 if there is none.
+func (t *Table) lastVisibleColumn() int {
+	for i := len(t.columnWidths) - 1; i >= 0; i-- {
+		if !t.isHidden(i) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,113 @@
+package colorbear
+
+import (
+	"strings"
+	"testing"
+)
+
+func newFitTable(opts ...TableOption) *Table {
+	table := NewTable(opts...)
+	table.SetHeaders("ID", "Name", "Description")
+	table.AddRow("1", "colorbear", "Terminal colors, tables, progress bars and spinners")
+	table.AddRow("2", "example", "short")
+	return table
+}
+
+func maxLineWidth(output string) int {
+	width := 0
+	for _, line := range strings.Split(strings.TrimSuffix(output, "\n"), "\n") {
+		width = maxInt(width, visualWidth(line))
+	}
+	return width
+}
+
+func TestTableMaxTableWidth(t *testing.T) {
+	table := newFitTable(WithMaxTableWidth(40))
+	output := table.String()
+
+	if width := maxLineWidth(output); width != 40 {
+		t.Errorf("Expected table width 40, got %d:\n%s", width, output)
+	}
+
+	// The widest column is shrunk, the others keep their width
+	if table.columnWidths[0] != 2 || table.columnWidths[1] != 9 {
+		t.Errorf("Expected ID and Name to keep their widths, got %v", table.columnWidths)
+	}
+	if !strings.Contains(output, "spinners") {
+		t.Errorf("Expected wrapped text to be complete:\n%s", output)
+	}
+}
+
+func TestTableFitUnlimited(t *testing.T) {
+	table := newFitTable()
+	table.calculateColumnWidths()
+
+	if table.columnWidths[2] != visualWidth("Terminal colors, tables, progress bars and spinners") {
+		t.Errorf("Expected unlimited width without options, got %v", table.columnWidths)
+	}
+}
+
+func TestTableFitPriorities(t *testing.T) {
+	table := newFitTable(
+		WithMaxTableWidth(40),
+		WithColumnPriorities(0, 0, 10),
+	)
+	table.calculateColumnWidths()
+
+	// Name has a lower priority, so it is shrunk to the minimum first
+	if table.columnWidths[1] != defaultMinFitWidth {
+		t.Errorf("Expected Name to be shrunk to %d, got %v", defaultMinFitWidth, table.columnWidths)
+	}
+	if table.columnWidths[0] != 2 {
+		t.Errorf("Expected ID to stay below the minimum width, got %v", table.columnWidths)
+	}
+}
+
+func TestTableFitMinWidths(t *testing.T) {
+	table := newFitTable(
+		WithMaxTableWidth(20),
+		WithColumnMinWidths(0, 9, 8),
+	)
+	output := table.String()
+
+	if table.columnWidths[1] != 9 || table.columnWidths[2] != 8 {
+		t.Errorf("Expected minimum widths to be honored, got %v", table.columnWidths)
+	}
+	if width := maxLineWidth(output); width <= 20 {
+		t.Errorf("Expected table to stay wider than the limit, got %d", width)
+	}
+}
+
+func TestTableHideColumns(t *testing.T) {
+	table := newFitTable(
+		WithMaxTableWidth(20),
+		WithColumnPriorities(10, 5, 0),
+		WithHideColumns(true),
+	)
+	output := table.String()
+
+	if strings.Contains(output, "Description") {
+		t.Errorf("Expected lowest-priority column to be hidden:\n%s", output)
+	}
+	if !strings.Contains(output, "│ ID │ Name") {
+		t.Errorf("Expected remaining columns to be drawn:\n%s", output)
+	}
+	if width := maxLineWidth(output); width > 20 {
+		t.Errorf("Expected table width <= 20, got %d:\n%s", width, output)
+	}
+
+	// Borders must match the visible columns
+	lines := strings.Split(output, "\n")
+	if strings.Count(lines[0], "┬") != 1 {
+		t.Errorf("Expected one column junction in the top border, got %q", lines[0])
+	}
+}
+
+func TestTableFitToTerminal(t *testing.T) {
+	t.Setenv("COLUMNS", "50")
+
+	output := newFitTable(WithFitToTerminal(true)).String()
+	if width := maxLineWidth(output); width != 50 {
+		t.Errorf("Expected table width 50 from COLUMNS, got %d:\n%s", width, output)
+	}
+}
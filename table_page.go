@@ -0,0 +1,400 @@
+// table_page.go
+package colorbear
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Terminal sequences used by the pager.
+const (
+	enterAltScreen = "\033[?1049h" // Switch to the alternate screen
+	leaveAltScreen = "\033[?1049l" // Back to the normal screen
+	cursorHome     = "\033[H"      // Move the cursor to the top left
+	clearLineEnd   = "\033[K"      // Erase to the end of the line
+	reverseVideo   = "\033[7m"     // Swap foreground and background
+)
+
+// Pages splits the table into pages of at most pageSize data rows.
+//
+// Every page is a complete table with the title, the headers and the
+// borders, and all pages share the same column widths. The footer and the
+// caption are only shown on the last page. Separators at page breaks are
+// left out; cells spanning rows across a page break are repeated on the
+// next page.
+//
+// Example:
+//
+//	for i, page := range table.Pages(20) {
+//	    fmt.Printf("Page %d\n%s\n", i+1, page)
+//	}
+func (t *Table) Pages(pageSize int) []string {
+	t.calculateColumnWidths()
+	layout := t.rowLayout(len(t.columnWidths))
+
+	data := []int{}
+	for i, row := range t.rows {
+		if !row.separator {
+			data = append(data, i)
+		}
+	}
+	if pageSize <= 0 || len(data) <= pageSize {
+		var output strings.Builder
+		t.writeHead(&output, layout)
+		t.writeBody(&output, layout)
+		return []string{output.String()}
+	}
+
+	footer := t.footerCells()
+	pages := []string{}
+	for i := 0; i < len(data); i += pageSize {
+		first, last := data[i], data[minInt(i+pageSize, len(data))-1]
+
+		page := *t
+		page.rows = t.rows[first : last+1]
+		page.firstRow = first // Row colors continue across pages
+		page.footer, page.summary, page.caption = nil, nil, ""
+		if i+pageSize >= len(data) {
+			page.footer, page.caption = footer, t.caption
+		}
+
+		// Show cells spanning into the page in its first row
+		pageLayout := append([][]cellSlot(nil), layout[first:last+1]...)
+		pageLayout[0] = append([]cellSlot(nil), pageLayout[0]...)
+		for j := range pageLayout[0] {
+			pageLayout[0][j].continued = false
+		}
+
+		var output strings.Builder
+		page.writeHead(&output, pageLayout)
+		page.writeBody(&output, pageLayout)
+		pages = append(pages, output.String())
+	}
+	return pages
+}
+
+// Page shows the table in an interactive pager when stdin and stdout are
+// a terminal, and prints it like Print otherwise (or if it fits on the
+// screen).
+//
+// The header stays at the top while the rows scroll; lines wider than the
+// terminal are cut off with an ellipsis:
+//
+//	↑/↓, j/k          scroll one line
+//	PgUp/PgDn, b/Space scroll one page
+//	Home/End, g/G     go to the start or end
+//	/                 search (case-insensitive), n/N for the next/previous match
+//	q, Esc, Ctrl+C    quit
+//
+// Example:
+//
+//	if err := table.Page(); err != nil {
+//	    log.Fatal(err)
+//	}
+func (t *Table) Page() error {
+	in, out := os.Stdin, os.Stdout
+	if !isTerminalWriter(in) || !isTerminalWriter(out) {
+		t.Print()
+		return nil
+	}
+
+	header, body := t.pagerLines()
+	width, height := terminalWidth(out), terminalHeight(out)
+	if height <= 0 || len(header)+len(body) < height {
+		t.Print()
+		return nil
+	}
+
+	restore, err := makeRaw(in.Fd())
+	if err != nil {
+		t.Print()
+		return nil
+	}
+	defer restore()
+
+	fmt.Fprint(out, enterAltScreen+hideCursor)
+	defer fmt.Fprint(out, showCursor+leaveAltScreen)
+
+	return newPager(header, body, width, height, in, out).run()
+}
+
+// newPager creates a pager for the given lines and terminal size. A header
+// too tall for the screen scrolls with the body, so that at least one body
+// line and the status line stay visible.
+func newPager(header, body []string, width, height int, in io.Reader, out io.Writer) *pager {
+	if len(header) > height-2 {
+		body = append(append([]string(nil), header...), body...)
+		header = nil
+	}
+	if rawReadTimeout {
+		in = timeoutReader{in}
+	}
+	return &pager{header: header, body: body, width: width, height: height, in: bufio.NewReader(in), out: out}
+}
+
+// errReadTimeout is returned when no input arrived within the read timeout
+// of the terminal (see makeRaw).
+var errReadTimeout = errors.New("colorbear: read timeout")
+
+// timeoutReader reports the empty reads of a terminal in raw mode as
+// errReadTimeout.
+type timeoutReader struct {
+	r io.Reader
+}
+
+func (t timeoutReader) Read(p []byte) (int, error) {
+	n, err := t.r.Read(p)
+	if n == 0 && (err == nil || err == io.EOF) {
+		return 0, errReadTimeout
+	}
+	return n, err
+}
+
+// pagerLines renders the table as the lines kept at the top of the pager
+// (title, top border, header) and the lines that scroll. In the vertical
+// layout, all lines scroll.
+func (t *Table) pagerLines() (header, body []string) {
+	if t.isVertical() {
+		return nil, splitLines(t.verticalString())
+	}
+
+	t.calculateColumnWidths()
+	layout := t.rowLayout(len(t.columnWidths))
+
+	var head, rest strings.Builder
+	t.writeHead(&head, layout)
+	t.writeBody(&rest, layout)
+	return splitLines(head.String()), splitLines(rest.String())
+}
+
+// splitLines splits output into lines without the final newline.
+func splitLines(output string) []string {
+	if output == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(output, "\n"), "\n")
+}
+
+// pager scrolls through the lines of a table.
+type pager struct {
+	header  []string // Lines that stay at the top
+	body    []string // Lines that scroll
+	width   int      // Terminal width in columns (0 if unknown)
+	height  int      // Terminal height in rows
+	top     int      // First visible body line
+	query   string   // Last search
+	message string   // Shown in the status line until the next key
+	in      *bufio.Reader
+	out     io.Writer
+}
+
+// run shows the pager until the user quits or the input ends.
+func (p *pager) run() error {
+	for {
+		p.draw("")
+		key, err := readKey(p.in)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		p.message = ""
+		switch key {
+		case "q", "esc", "ctrl+c":
+			return nil
+		case "down", "j", "enter":
+			p.scroll(1)
+		case "up", "k":
+			p.scroll(-1)
+		case "pgdn", " ", "f":
+			p.scroll(p.rows())
+		case "pgup", "b":
+			p.scroll(-p.rows())
+		case "home", "g":
+			p.scroll(-len(p.body))
+		case "end", "G":
+			p.scroll(len(p.body))
+		case "/":
+			if err := p.prompt(); err != nil {
+				return err
+			}
+			p.search(p.top, 1)
+		case "n":
+			p.search(p.top+1, 1)
+		case "N":
+			p.search(p.top-1, -1)
+		}
+	}
+}
+
+// rows returns the number of body lines on the screen.
+func (p *pager) rows() int {
+	return maxInt(1, p.height-len(p.header)-1)
+}
+
+// scroll moves the view by n lines, staying within the body.
+func (p *pager) scroll(n int) {
+	p.top = maxInt(0, minInt(p.top+n, len(p.body)-p.rows()))
+}
+
+// prompt reads a search query, shown in the status line. Esc cancels.
+func (p *pager) prompt() error {
+	query := ""
+	for {
+		p.draw("/" + query)
+		key, err := readKey(p.in)
+		if err != nil && err != io.EOF {
+			return err
+		}
+		switch {
+		case err == io.EOF || key == "enter":
+			if query != "" {
+				p.query = query
+			}
+			return nil
+		case key == "esc" || key == "ctrl+c":
+			return nil
+		case key == "backspace":
+			if runes := []rune(query); len(runes) > 0 {
+				query = string(runes[:len(runes)-1])
+			}
+		case len([]rune(key)) == 1:
+			query += key
+		}
+	}
+}
+
+// search scrolls to the first body line from index from (in direction
+// step, wrapping around) that contains the query.
+func (p *pager) search(from, step int) {
+	if p.query == "" || len(p.body) == 0 {
+		return
+	}
+	query := strings.ToLower(p.query)
+	for i := 0; i < len(p.body); i++ {
+		line := ((from+i*step)%len(p.body) + len(p.body)) % len(p.body)
+		if strings.Contains(strings.ToLower(stripANSI(p.body[line])), query) {
+			p.top = line
+			p.scroll(0)
+			return
+		}
+	}
+	p.message = "Pattern not found: " + p.query
+}
+
+// draw redraws the screen. The status line shows status if set, the
+// position and key help otherwise.
+func (p *pager) draw(status string) {
+	var out strings.Builder
+	out.WriteString(cursorHome)
+
+	for _, line := range p.header {
+		out.WriteString(p.fit(line) + clearLineEnd + "\n")
+	}
+	end := minInt(p.top+p.rows(), len(p.body))
+	for _, line := range p.body[p.top:end] {
+		out.WriteString(p.fit(line) + clearLineEnd + "\n")
+	}
+	for i := end - p.top; i < p.rows(); i++ {
+		out.WriteString("~" + clearLineEnd + "\n")
+	}
+
+	if status == "" {
+		status = p.message
+	}
+	if status == "" {
+		status = fmt.Sprintf("Lines %d-%d of %d  ↑/↓ PgUp/PgDn scroll  / search  q quit", p.top+1, end, len(p.body))
+	}
+	out.WriteString(reverseVideo + p.fit(status) + Reset + clearDown)
+
+	fmt.Fprint(p.out, out.String())
+}
+
+// fit truncates a line wider than the terminal, so that it does not wrap
+// and push the other lines off the screen.
+func (p *pager) fit(line string) string {
+	if p.width <= 0 || visualWidth(line) <= p.width {
+		return line
+	}
+	return truncateLine(line, p.width)
+}
+
+// readKey reads one key press: a printable character, or the name of a
+// special key ("up", "pgdn", "enter", "esc", ...).
+func readKey(in *bufio.Reader) (string, error) {
+	b, err := in.ReadByte()
+	for err == errReadTimeout {
+		b, err = in.ReadByte() // Still waiting for a key
+	}
+	if err != nil {
+		return "", err
+	}
+
+	switch b {
+	case 0x1b:
+		if !rawReadTimeout && in.Buffered() == 0 {
+			return "esc", nil
+		}
+		return readEscape(in)
+	case 3:
+		return "ctrl+c", nil
+	case '\r', '\n':
+		return "enter", nil
+	case 127, 8:
+		return "backspace", nil
+	}
+
+	if b < 0x80 {
+		return string(b), nil
+	}
+	in.UnreadByte()
+	r, _, err := in.ReadRune()
+	return string(r), err
+}
+
+// escapeKeys maps the final part of escape sequences to key names.
+var escapeKeys = map[string]string{
+	"A": "up", "B": "down",
+	"5~": "pgup", "6~": "pgdn",
+	"H": "home", "1~": "home", "7~": "home",
+	"F": "end", "4~": "end", "8~": "end",
+}
+
+// readEscape reads the rest of an escape sequence after ESC, such as
+// "[A" or "[5~". If nothing follows within the read timeout, the key was
+// a lone Esc. Unknown or incomplete sequences return an empty key.
+func readEscape(in *bufio.Reader) (string, error) {
+	b, err := in.ReadByte()
+	if err == errReadTimeout || err == io.EOF {
+		return "esc", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if b != '[' && b != 'O' {
+		in.UnreadByte() // A lone Esc followed by another key
+		return "esc", nil
+	}
+
+	var seq strings.Builder
+	for {
+		b, err := in.ReadByte()
+		if err == errReadTimeout {
+			return "", nil // Incomplete sequence
+		}
+		if err != nil {
+			return "", err
+		}
+		seq.WriteByte(b)
+		if b >= 0x40 && b <= 0x7e {
+			break
+		}
+	}
+	return escapeKeys[seq.String()], nil
+}
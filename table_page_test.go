@@ -0,0 +1,237 @@
+package colorbear
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestTablePages(t *testing.T) {
+	table := NewTable()
+	table.SetHeaders("Name", "Count")
+	table.SetFooter("Total", "6")
+	table.SetCaption("Inventory")
+	table.AddRow("apples", "1")
+	table.AddRow("pears", "2")
+	table.AddSeparator()
+	table.AddRow("plums", "3")
+
+	pages := table.Pages(2)
+	if len(pages) != 2 {
+		t.Fatalf("Expected 2 pages, got %d", len(pages))
+	}
+
+	for i, page := range pages {
+		if !strings.Contains(page, "Name") {
+			t.Errorf("Expected headers on page %d, got:\n%s", i+1, page)
+		}
+	}
+	if strings.Contains(pages[0], "Total") || strings.Contains(pages[0], "Inventory") {
+		t.Errorf("Expected no footer or caption on the first page, got:\n%s", pages[0])
+	}
+	if !strings.Contains(pages[1], "Total") || !strings.Contains(pages[1], "Inventory") {
+		t.Errorf("Expected footer and caption on the last page, got:\n%s", pages[1])
+	}
+	if !strings.Contains(pages[0], "pears") || strings.Contains(pages[0], "plums") {
+		t.Errorf("Expected the first two rows on the first page, got:\n%s", pages[0])
+	}
+
+	// All pages have the same width
+	width := visualWidth(strings.SplitN(pages[0], "\n", 2)[0])
+	if other := visualWidth(strings.SplitN(pages[1], "\n", 2)[0]); other != width {
+		t.Errorf("Expected pages of width %d, got %d", width, other)
+	}
+}
+
+func TestTablePagesRowColors(t *testing.T) {
+	ForceColors(true)
+	defer ForceColors(false)
+
+	table := NewTable(WithRowColors(RedCode, BlueCode))
+	table.writer = &bytes.Buffer{} // Colors follow ForceColors, as for a stream
+	table.SetHeaders("Name")
+	for _, name := range []string{"one", "two", "three", "four"} {
+		table.AddRow(name)
+	}
+
+	pages := table.Pages(3)
+	if len(pages) != 2 {
+		t.Fatalf("Expected 2 pages, got %d", len(pages))
+	}
+	if !strings.Contains(pages[1], BlueCode+"four") {
+		t.Errorf("Expected the fourth row to keep its color on the next page, got %q", pages[1])
+	}
+}
+
+func TestTablePagesSinglePage(t *testing.T) {
+	table := NewTable()
+	table.SetHeaders("Name")
+	table.AddRow("apples")
+
+	for _, size := range []int{0, 1, 10} {
+		pages := table.Pages(size)
+		if len(pages) != 1 || pages[0] != table.String() {
+			t.Errorf("Expected the whole table for page size %d, got %q", size, pages)
+		}
+	}
+}
+
+func TestTablePagesRowspan(t *testing.T) {
+	table := NewTable()
+	table.SetHeaders("Region", "City")
+	table.AddCells(NewCell("Europe").Rowspan(2), NewCell("Berlin"))
+	table.AddRow("Paris")
+
+	pages := table.Pages(1)
+	if len(pages) != 2 {
+		t.Fatalf("Expected 2 pages, got %d", len(pages))
+	}
+	if !strings.Contains(pages[1], "Europe") || !strings.Contains(pages[1], "Paris") {
+		t.Errorf("Expected the spanning cell repeated on the second page, got:\n%s", pages[1])
+	}
+}
+
+func newTestPager(input string, lines int) (*pager, *bytes.Buffer) {
+	body := make([]string, lines)
+	for i := range body {
+		body[i] = "line " + string(rune('a'+i))
+	}
+	out := &bytes.Buffer{}
+	return &pager{
+		header: []string{"header"},
+		body:   body,
+		height: 5, // 3 body lines and the status line
+		in:     bufio.NewReader(strings.NewReader(input)),
+		out:    out,
+	}, out
+}
+
+func TestPagerScroll(t *testing.T) {
+	tests := []struct {
+		input string
+		top   int
+	}{
+		{"j", 1},
+		{"jjk", 1},
+		{"\033[B\033[B", 2},
+		{"\033[6~", 3},
+		{"\033[6~\033[5~", 0},
+		{"G", 7},
+		{"Gg", 0},
+		{"jjjjjjjjjjjj", 7},
+		{"kkk", 0},
+	}
+
+	for _, test := range tests {
+		p, _ := newTestPager(test.input+"q", 10)
+		if err := p.run(); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if p.top != test.top {
+			t.Errorf("Expected top %d after %q, got %d", test.top, test.input, p.top)
+		}
+	}
+}
+
+func TestPagerSearch(t *testing.T) {
+	p, _ := newTestPager("/line e\rq", 10)
+	p.run()
+	if p.top != 4 {
+		t.Errorf("Expected search to scroll to line 4, got %d", p.top)
+	}
+
+	p, _ = newTestPager("/LINE\rnnq", 10)
+	p.run()
+	if p.top != 2 {
+		t.Errorf("Expected n to move to the next match, got %d", p.top)
+	}
+
+	p, out := newTestPager("/missing\r", 10)
+	p.run()
+	if !strings.Contains(out.String(), "Pattern not found: missing") {
+		t.Errorf("Expected a not found message, got %q", out.String())
+	}
+}
+
+func TestPagerDraw(t *testing.T) {
+	p, out := newTestPager("q", 2)
+	p.run()
+
+	output := out.String()
+	for _, expected := range []string{"header", "line a", "line b", "~", "Lines 1-2 of 2"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected %q in the pager output, got %q", expected, output)
+		}
+	}
+}
+
+func TestPagerFitsTerminalWidth(t *testing.T) {
+	p, out := newTestPager("q", 10)
+	p.body = []string{"a line that is much too wide"}
+	p.width = 10
+	p.run()
+
+	output := out.String()
+	if !strings.Contains(output, "a line th"+ellipsis+clearLineEnd) {
+		t.Errorf("Expected the line to be truncated to the terminal width, got %q", output)
+	}
+	if strings.Contains(output, "too wide") {
+		t.Errorf("Expected no text past the terminal width, got %q", output)
+	}
+}
+
+func TestReadKey(t *testing.T) {
+	in := bufio.NewReader(strings.NewReader("a\033[A\033[5~\033OF\r\x7fé\x03"))
+	expected := []string{"a", "up", "pgup", "end", "enter", "backspace", "é", "ctrl+c"}
+	for _, key := range expected {
+		got, err := readKey(in)
+		if err != nil || got != key {
+			t.Errorf("Expected key %q, got %q (%v)", key, got, err)
+		}
+	}
+}
+
+// chunkReader returns one chunk per read; an empty chunk is a read that
+// timed out.
+type chunkReader struct {
+	chunks []string
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	if len(r.chunks) == 0 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	chunk := r.chunks[0]
+	r.chunks = r.chunks[1:]
+	return copy(p, chunk), nil
+}
+
+func TestReadKeySplitSequence(t *testing.T) {
+	in := bufio.NewReader(timeoutReader{&chunkReader{[]string{"\033", "[B", "", "\033", "", "\033[", "6~", "\033", "q"}}})
+	expected := []string{"down", "esc", "pgdn", "esc", "q"}
+	for _, key := range expected {
+		got, err := readKey(in)
+		if err != nil || got != key {
+			t.Errorf("Expected key %q, got %q (%v)", key, got, err)
+		}
+	}
+}
+
+func TestPagerTallHeaderScrolls(t *testing.T) {
+	header := []string{"title", "top", "head", "rule"}
+	p := newPager(header, []string{"row 1", "row 2"}, 0, 4, strings.NewReader(""), &bytes.Buffer{})
+
+	if len(p.header) != 0 {
+		t.Errorf("Expected a header taller than the screen to scroll, got %q", p.header)
+	}
+	if len(p.body) != 6 || p.body[0] != "title" || p.body[5] != "row 2" {
+		t.Errorf("Expected the header lines before the rows, got %q", p.body)
+	}
+
+	p = newPager(header[:2], []string{"row 1"}, 0, 4, strings.NewReader(""), &bytes.Buffer{})
+	if len(p.header) != 2 {
+		t.Errorf("Expected a header that fits to stay fixed, got %q", p.header)
+	}
+}
@@ -0,0 +1,291 @@
+// table_reflect.go
+package colorbear
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// structColumn describes a table column derived from a struct field.
+type structColumn struct {
+	index  []int     // Field index path (supports embedded structs)
+	header string    // Column header
+	align  Alignment // Column alignment
+	format string    // fmt format verb for values (empty: default formatting)
+}
+
+// FromStructs fills the table from a slice of structs.
+//
+// Headers are derived from the exported field names. Every element of
+// the slice becomes one row. Pointers to structs are supported, nil
+// elements produce empty rows. Numeric fields are right-aligned.
+//
+// Fields can be customized with a `colorbear` struct tag:
+//   - The first value sets the header name
+//   - align=left|center|right sets the column alignment
+//   - format=<verb> formats the value with fmt (e.g., format=%.2f)
+//   - omit (or a tag of "-") skips the field
+//
+// Example:
+//
+//	type Server struct {
+//	    Name   string
+//	    CPU    float64       `colorbear:"CPU %,format=%.1f"`
+//	    Uptime time.Duration `colorbear:"Uptime,align=right"`
+//	    Secret string        `colorbear:"-"`
+//	}
+//
+//	table := colorbear.NewTable()
+//	if err := table.FromStructs(servers); err != nil {
+//	    return err
+//	}
+//	table.Print()
+//
+// An error is returned if data is not a slice or array of structs.
+func (t *Table) FromStructs(data interface{}) error {
+	value := reflect.ValueOf(data)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return fmt.Errorf("colorbear: FromStructs expects a slice of structs, got %T", data)
+	}
+
+	elemType := value.Type().Elem()
+	for elemType.Kind() == reflect.Ptr {
+		elemType = elemType.Elem()
+	}
+	if elemType.Kind() != reflect.Struct {
+		return fmt.Errorf("colorbear: FromStructs expects a slice of structs, got %T", data)
+	}
+
+	columns := structColumns(elemType)
+	if len(columns) == 0 {
+		return errors.New("colorbear: FromStructs found no exported fields")
+	}
+
+	headers := make([]string, len(columns))
+	alignments := make([]Alignment, len(columns))
+	for i, col := range columns {
+		headers[i] = col.header
+		alignments[i] = col.align
+	}
+	t.SetHeaders(headers...)
+	t.options.Alignment = alignments
+
+	for i := 0; i < value.Len(); i++ {
+		t.AddRow(structRow(value.Index(i), columns)...)
+	}
+	return nil
+}
+
+// FromMaps fills the table from a slice of maps.
+//
+// If headers were set before, they define the columns and their order;
+// keys not among the headers are ignored. Otherwise the columns are the
+// union of all keys, sorted alphabetically so the order is stable.
+// Missing keys produce empty cells. Values are formatted like in
+// FromStructs.
+//
+// Example:
+//
+//	table := colorbear.NewTable()
+//	table.SetHeaders("name", "status")
+//	table.FromMaps([]map[string]interface{}{
+//	    {"name": "api", "status": "up"},
+//	    {"name": "worker", "status": "down"},
+//	})
+func (t *Table) FromMaps(rows []map[string]interface{}) *Table {
+	keys := t.headers
+	if len(keys) == 0 {
+		keys = mapKeys(rows)
+		t.SetHeaders(keys...)
+	}
+
+	for _, row := range rows {
+		cells := make([]string, len(keys))
+		for i, key := range keys {
+			if value, ok := row[key]; ok {
+				cells[i] = formatValue(reflect.ValueOf(value), "")
+			}
+		}
+		t.AddRow(cells...)
+	}
+	return t
+}
+
+// structColumns derives the columns for a struct type.
+func structColumns(typ reflect.Type) []structColumn {
+	columns := []structColumn{}
+
+	for _, field := range reflect.VisibleFields(typ) {
+		if field.Anonymous || !field.IsExported() {
+			continue
+		}
+
+		col, ok := parseStructTag(field)
+		if !ok {
+			continue
+		}
+		columns = append(columns, col)
+	}
+	return columns
+}
+
+// parseStructTag builds a column from a field and its `colorbear` tag.
+// It returns false if the field should be omitted.
+func parseStructTag(field reflect.StructField) (structColumn, bool) {
+	col := structColumn{
+		index:  field.Index,
+		header: field.Name,
+		align:  defaultAlignment(field.Type),
+	}
+
+	tag, ok := field.Tag.Lookup("colorbear")
+	if !ok {
+		return col, true
+	}
+	if tag == "-" {
+		return col, false
+	}
+
+	parts := strings.Split(tag, ",")
+	if parts[0] != "" {
+		col.header = parts[0]
+	}
+
+	for _, part := range parts[1:] {
+		key, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch key {
+		case "omit":
+			return col, false
+		case "align":
+			col.align = parseAlignment(value, col.align)
+		case "format":
+			col.format = value
+		}
+	}
+	return col, true
+}
+
+// parseAlignment converts "left", "center" or "right" to an Alignment.
+func parseAlignment(name string, fallback Alignment) Alignment {
+	switch strings.ToLower(name) {
+	case "left":
+		return AlignLeft
+	case "center":
+		return AlignCenter
+	case "right":
+		return AlignRight
+	default:
+		return fallback
+	}
+}
+
+// defaultAlignment right-aligns numbers and durations, left-aligns the rest.
+func defaultAlignment(typ reflect.Type) Alignment {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return AlignRight
+	default:
+		return AlignLeft
+	}
+}
+
+// structRow formats the fields of one struct value as table cells.
+func structRow(value reflect.Value, columns []structColumn) []string {
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return make([]string, len(columns))
+		}
+		value = value.Elem()
+	}
+
+	cells := make([]string, len(columns))
+	for i, col := range columns {
+		field, err := value.FieldByIndexErr(col.index)
+		if err != nil {
+			continue // Field of a nil embedded pointer
+		}
+		cells[i] = formatValue(field, col.format)
+	}
+	return cells
+}
+
+// formatValue converts a value to cell text.
+//
+// Common types get a readable representation:
+//   - time.Time: "2006-01-02 15:04:05" (empty for the zero time)
+//   - time.Duration: like progress bar times (e.g., "2.3s", "1m30s")
+//   - bool: "yes" or "no"
+//   - floats: shortest representation without exponent (e.g., "3.5")
+//   - nil pointers and interfaces: empty
+//
+// If format is set, it is used with fmt.Sprintf instead.
+func formatValue(value reflect.Value, format string) string {
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return ""
+		}
+		value = value.Elem()
+	}
+	if !value.IsValid() {
+		return ""
+	}
+	if !value.CanInterface() {
+		return fmt.Sprint(value)
+	}
+
+	v := value.Interface()
+	if format != "" {
+		return fmt.Sprintf(format, v)
+	}
+
+	switch x := v.(type) {
+	case time.Time:
+		if x.IsZero() {
+			return ""
+		}
+		return x.Format("2006-01-02 15:04:05")
+	case time.Duration:
+		return formatDuration(x)
+	case bool:
+		if x {
+			return "yes"
+		}
+		return "no"
+	case float32:
+		return strconv.FormatFloat(float64(x), 'f', -1, 32)
+	case float64:
+		return strconv.FormatFloat(x, 'f', -1, 64)
+	case fmt.Stringer:
+		return x.String()
+	case error:
+		return x.Error()
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// mapKeys returns the sorted union of all keys.
+func mapKeys(rows []map[string]interface{}) []string {
+	seen := map[string]bool{}
+	keys := []string{}
+	for _, row := range rows {
+		for key := range row {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,127 @@
+package colorbear
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+type reflectBase struct {
+	ID int
+}
+
+type reflectServer struct {
+	reflectBase
+	Name    string
+	CPU     float64       `colorbear:"CPU %,format=%.1f"`
+	Uptime  time.Duration `colorbear:",align=center"`
+	Healthy bool
+	Secret  string `colorbear:"-"`
+	Token   string `colorbear:"token,omit"`
+	private string
+}
+
+func TestFromStructs(t *testing.T) {
+	servers := []reflectServer{
+		{reflectBase{1}, "api", 12.345, 90 * time.Second, true, "s", "t", "p"},
+		{reflectBase{2}, "db", 80, 2 * time.Second, false, "s", "t", "p"},
+	}
+
+	table := NewTable()
+	if err := table.FromStructs(servers); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expectedHeaders := []string{"ID", "Name", "CPU %", "Uptime", "Healthy"}
+	if strings.Join(table.headers, "|") != strings.Join(expectedHeaders, "|") {
+		t.Errorf("Expected headers %v, got %v", expectedHeaders, table.headers)
+	}
+
+	expectedRow := []string{"1", "api", "12.3", "1m30s", "yes"}
+	if strings.Join(table.rows[0].texts(), "|") != strings.Join(expectedRow, "|") {
+		t.Errorf("Expected row %v, got %v", expectedRow, table.rows[0].texts())
+	}
+	if table.rows[1].text(4) != "no" {
+		t.Errorf("Expected 'no' for false, got %q", table.rows[1].text(4))
+	}
+
+	expectedAlign := []Alignment{AlignRight, AlignLeft, AlignRight, AlignCenter, AlignLeft}
+	for i, align := range expectedAlign {
+		if table.getAlignment(i) != align {
+			t.Errorf("Expected alignment %d for column %d, got %d", align, i, table.getAlignment(i))
+		}
+	}
+}
+
+func TestFromStructsPointers(t *testing.T) {
+	type item struct {
+		Name  string
+		Price *float64
+	}
+	price := 2.5
+
+	table := NewTable()
+	if err := table.FromStructs([]*item{{"apple", &price}, nil, {"pear", nil}}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if table.RowCount() != 3 {
+		t.Fatalf("Expected 3 rows, got %d", table.RowCount())
+	}
+	if table.rows[0].text(1) != "2.5" {
+		t.Errorf("Expected '2.5', got %q", table.rows[0].text(1))
+	}
+	if table.rows[1].text(0) != "" || table.rows[2].text(1) != "" {
+		t.Errorf("Expected empty cells for nil values, got %v and %v", table.rows[1].texts(), table.rows[2].texts())
+	}
+}
+
+func TestFromStructsErrors(t *testing.T) {
+	type hidden struct {
+		name string
+	}
+
+	tests := []interface{}{
+		"not a slice",
+		[]int{1, 2},
+		[]hidden{{"x"}},
+	}
+
+	for _, data := range tests {
+		if err := NewTable().FromStructs(data); err == nil {
+			t.Errorf("Expected error for %T", data)
+		}
+	}
+}
+
+func TestFromMaps(t *testing.T) {
+	rows := []map[string]interface{}{
+		{"name": "api", "status": "up", "checked": time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)},
+		{"name": "worker", "replicas": 3},
+	}
+
+	table := NewTable().FromMaps(rows)
+
+	expectedHeaders := []string{"checked", "name", "replicas", "status"}
+	if strings.Join(table.headers, "|") != strings.Join(expectedHeaders, "|") {
+		t.Errorf("Expected headers %v, got %v", expectedHeaders, table.headers)
+	}
+	if table.rows[0].text(0) != "2024-05-01 12:00:00" {
+		t.Errorf("Expected formatted time, got %q", table.rows[0].text(0))
+	}
+	if table.rows[1].text(2) != "3" || table.rows[1].text(3) != "" {
+		t.Errorf("Expected '3' and empty cell, got %v", table.rows[1].texts())
+	}
+}
+
+func TestFromMapsWithHeaders(t *testing.T) {
+	table := NewTable()
+	table.SetHeaders("status", "name")
+	table.FromMaps([]map[string]interface{}{
+		{"name": "api", "status": "up", "ignored": true},
+	})
+
+	if strings.Join(table.rows[0].texts(), "|") != "up|api" {
+		t.Errorf("Expected columns in header order, got %v", table.rows[0].texts())
+	}
+}
@@ -0,0 +1,338 @@
+// table_sort.go
+package colorbear
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// SortOrder is the direction of a sort.
+type SortOrder int
+
+const (
+	SortAscending  SortOrder = iota // Smallest first (A-Z, 0-9)
+	SortDescending                  // Largest first (Z-A, 9-0)
+)
+
+// Comparator compares two cell values and returns a negative number if
+// a sorts before b, a positive number if a sorts after b, and 0 if they
+// are equal.
+//
+// The predefined comparators ignore ANSI color codes, so colored cells
+// sort by their text.
+type Comparator func(a, b string) int
+
+// Predefined comparators
+var (
+	// CompareString compares cells byte-wise (case-sensitive).
+	CompareString Comparator = compareString
+
+	// CompareNatural compares cells case-insensitively, with runs of
+	// digits compared by their numeric value ("file2" < "file10").
+	// This is the default for SortBy.
+	CompareNatural Comparator = compareNatural
+
+	// CompareNumeric compares cells as numbers. Currency symbols,
+	// thousands separators and trailing units are ignored
+	// ("$1,250.00", "42%", "3.5 GiB"). Cells that are not numbers sort
+	// after all numbers (before them in descending order).
+	CompareNumeric Comparator = compareNumeric
+
+	// CompareDate compares cells as dates and times. Supported formats
+	// include RFC 3339, "2006-01-02 15:04:05", "2006-01-02", "02.01.2006",
+	// "01/02/2006" and "Jan 2, 2006". Cells that are not dates sort after
+	// all dates (before them in descending order).
+	CompareDate Comparator = compareDate
+
+	// CompareDuration compares cells as Go durations ("1m30s", "2.5s").
+	// Cells that are not durations sort after all durations (before them
+	// in descending order).
+	CompareDuration Comparator = compareDuration
+)
+
+// SortBy sorts the rows by a column using natural order (see
+// CompareNatural).
+//
+// Rows with equal values keep their relative order, so sorting by one
+// column and then another sorts by both. Separators stay in place: rows
+// are only sorted within the sections between separators.
+//
+// Example:
+//
+//	table.SortBy(0, colorbear.SortAscending)  // By name
+//	table.SortBy(2, colorbear.SortDescending) // By date, then by name
+func (t *Table) SortBy(column int, order SortOrder) *Table {
+	return t.SortByFunc(column, order, CompareNatural)
+}
+
+// SortByFunc sorts the rows by a column using the given comparator.
+//
+// Example:
+//
+//	table.SortByFunc(1, colorbear.SortDescending, colorbear.CompareNumeric)
+//	table.SortByFunc(2, colorbear.SortAscending, colorbear.CompareDate)
+func (t *Table) SortByFunc(column int, order SortOrder, compare Comparator) *Table {
+	start := 0
+	for i := 0; i <= len(t.rows); i++ {
+		if i < len(t.rows) && !t.rows[i].separator {
+			continue
+		}
+
+		section := t.rows[start:i]
+		sort.SliceStable(section, func(a, b int) bool {
+			result := compare(section[a].text(column), section[b].text(column))
+			if order == SortDescending {
+				return result > 0
+			}
+			return result < 0
+		})
+		start = i + 1
+	}
+	t.columnWidths = []int{} // Row spans may cover other rows now
+	return t
+}
+
+// Filter keeps only the rows for which keep returns true.
+//
+// keep receives the cells as they were added (including color codes),
+// one per column; cells spanning several columns are followed by empty
+// cells.
+// Separators are kept between remaining rows; separators that would end
+// up at the start, at the end or next to each other are removed.
+//
+// Example:
+//
+//	table.Filter(func(row []string) bool {
+//	    return row[2] != "stopped"
+//	})
+func (t *Table) Filter(keep func(row []string) bool) *Table {
+	rows := []tableRow{}
+	for _, row := range t.rows {
+		if row.separator {
+			if len(rows) > 0 && !rows[len(rows)-1].separator {
+				rows = append(rows, row)
+			}
+			continue
+		}
+		if keep(row.texts()) {
+			rows = append(rows, row)
+		}
+	}
+
+	if len(rows) > 0 && rows[len(rows)-1].separator {
+		rows = rows[:len(rows)-1]
+	}
+
+	t.rows = rows
+	t.columnWidths = []int{}
+	return t
+}
+
+// GroupBy groups the rows by the value of a column.
+//
+// Groups appear in the order of their first row, rows keep their order
+// within a group. Each group starts with a header row showing the value
+// in bold, and groups are divided by separators. Existing separators are
+// removed. Sort first to order the groups.
+//
+// Example:
+//
+//	table.SetHeaders("Category", "Item", "Price")
+//	table.AddRow("Fruits", "Apple", "$2.99")
+//	table.AddRow("Vegetables", "Carrot", "$1.99")
+//	table.AddRow("Fruits", "Banana", "$1.49")
+//	table.GroupBy(0)
+//	// Output:
+//	// │ Fruits     │        │       │
+//	// │ Fruits     │ Apple  │ $2.99 │
+//	// │ Fruits     │ Banana │ $1.49 │
+//	// ├────────────┼────────┼───────┤
+//	// │ Vegetables │        │       │
+//	// │ Vegetables │ Carrot │ $1.99 │
+func (t *Table) GroupBy(column int) *Table {
+	keys := []string{}
+	groups := map[string][]tableRow{}
+
+	for _, row := range t.rows {
+		if row.separator {
+			continue
+		}
+		key := stripANSI(row.text(column))
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], row)
+	}
+
+	rows := []tableRow{}
+	for i, key := range keys {
+		if i > 0 {
+			rows = append(rows, tableRow{separator: true})
+		}
+		rows = append(rows, t.groupHeader(key))
+		rows = append(rows, groups[key]...)
+	}
+
+	t.rows = rows
+	t.columnWidths = []int{}
+	return t
+}
+
+// groupHeader builds the header row of a group: the value in bold in the
+// first column, all other cells empty.
+func (t *Table) groupHeader(value string) tableRow {
+	cells := textCells(make([]string, maxInt(1, t.determineColumnCount())))
+	cells[0].text = value
+	cells[0].style = NewStyle().Bold()
+	return tableRow{cells: cells}
+}
+
+// compareString compares ANSI-stripped cells byte-wise.
+func compareString(a, b string) int {
+	return strings.Compare(stripANSI(a), stripANSI(b))
+}
+
+// compareNatural compares ANSI-stripped cells case-insensitively, with
+// digit runs compared numerically. Ties are broken byte-wise.
+func compareNatural(a, b string) int {
+	x, y := []rune(stripANSI(a)), []rune(stripANSI(b))
+	i, j := 0, 0
+
+	for i < len(x) && j < len(y) {
+		if unicode.IsDigit(x[i]) && unicode.IsDigit(y[j]) {
+			// Compare digit runs by value: longer (without leading zeros) is larger
+			si, sj := i, j
+			for i < len(x) && unicode.IsDigit(x[i]) {
+				i++
+			}
+			for j < len(y) && unicode.IsDigit(y[j]) {
+				j++
+			}
+			nx := strings.TrimLeft(string(x[si:i]), "0")
+			ny := strings.TrimLeft(string(y[sj:j]), "0")
+			if len(nx) != len(ny) {
+				return len(nx) - len(ny)
+			}
+			if c := strings.Compare(nx, ny); c != 0 {
+				return c
+			}
+			continue
+		}
+
+		cx, cy := unicode.ToLower(x[i]), unicode.ToLower(y[j])
+		if cx != cy {
+			return int(cx) - int(cy)
+		}
+		i++
+		j++
+	}
+
+	if c := (len(x) - i) - (len(y) - j); c != 0 {
+		return c
+	}
+	return compareString(a, b)
+}
+
+// compareNumeric compares cells as numbers (see CompareNumeric).
+func compareNumeric(a, b string) int {
+	x, okX := parseNumber(a)
+	y, okY := parseNumber(b)
+	return compareParsed(okX, okY, func() int { return compareFloat(x, y) }, a, b)
+}
+
+// compareDate compares cells as dates (see CompareDate).
+func compareDate(a, b string) int {
+	x, okX := parseDate(a)
+	y, okY := parseDate(b)
+	return compareParsed(okX, okY, func() int { return x.Compare(y) }, a, b)
+}
+
+// compareDuration compares cells as durations (see CompareDuration).
+func compareDuration(a, b string) int {
+	x, errX := time.ParseDuration(strings.TrimSpace(stripANSI(a)))
+	y, errY := time.ParseDuration(strings.TrimSpace(stripANSI(b)))
+	return compareParsed(errX == nil, errY == nil, func() int { return compareFloat(float64(x), float64(y)) }, a, b)
+}
+
+// compareParsed orders parsed values before unparsable ones. Two
+// unparsable cells are compared naturally.
+func compareParsed(okA, okB bool, compare func() int, a, b string) int {
+	switch {
+	case okA && okB:
+		return compare()
+	case okA:
+		return -1
+	case okB:
+		return 1
+	default:
+		return compareNatural(a, b)
+	}
+}
+
+// compareFloat returns -1, 0 or 1.
+func compareFloat(x, y float64) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// parseNumber extracts the number from a cell such as "$1,250.00",
+// "-42%", "-$3.50" or "3.5 GiB".
+func parseNumber(cell string) (float64, bool) {
+	s := strings.TrimSpace(stripANSI(cell))
+	negative := strings.HasPrefix(s, "-")
+	s = strings.TrimLeft(strings.TrimPrefix(s, "-"), "$€£¥+")
+	if negative {
+		s = "-" + s
+	}
+	s = strings.NewReplacer(",", "", "_", "").Replace(s)
+
+	// Cut off trailing units
+	end := 0
+	if strings.HasPrefix(s, "-") {
+		end++
+	}
+	for end < len(s) && (s[end] == '.' || (s[end] >= '0' && s[end] <= '9')) {
+		end++
+	}
+
+	n, err := strconv.ParseFloat(s[:end], 64)
+	return n, err == nil
+}
+
+// dateLayouts are the formats tried by parseDate, most specific first.
+var dateLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	"02.01.2006 15:04",
+	"02.01.2006",
+	"01/02/2006 15:04",
+	"01/02/2006",
+	"Jan 2, 2006",
+	"2 Jan 2006",
+	time.RFC1123Z,
+	time.RFC1123,
+	time.UnixDate,
+	time.TimeOnly,
+}
+
+// parseDate parses a cell with the first matching layout.
+func parseDate(cell string) (time.Time, bool) {
+	s := strings.TrimSpace(stripANSI(cell))
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
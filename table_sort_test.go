@@ -0,0 +1,140 @@
+package colorbear
+
+import (
+	"strings"
+	"testing"
+)
+
+func columnValues(table *Table, column int) []string {
+	values := []string{}
+	for _, row := range table.rows {
+		if row.separator {
+			values = append(values, "---")
+			continue
+		}
+		values = append(values, stripANSI(row.text(column)))
+	}
+	return values
+}
+
+func TestTableSortBy(t *testing.T) {
+	table := NewTable()
+	table.AddRow("file10", "b")
+	table.AddRow("File2", "a")
+	table.AddRow("\033[31mfile1\033[0m", "c")
+
+	table.SortBy(0, SortAscending)
+	expected := "file1|File2|file10"
+	if result := strings.Join(columnValues(table, 0), "|"); result != expected {
+		t.Errorf("Expected %q, got %q", expected, result)
+	}
+
+	table.SortBy(1, SortDescending)
+	expected = "c|b|a"
+	if result := strings.Join(columnValues(table, 1), "|"); result != expected {
+		t.Errorf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestTableSortByStable(t *testing.T) {
+	table := NewTable()
+	table.AddRow("b", "1")
+	table.AddRow("a", "2")
+	table.AddRow("b", "3")
+	table.AddRow("a", "4")
+
+	table.SortBy(0, SortAscending)
+	expected := "2|4|1|3"
+	if result := strings.Join(columnValues(table, 1), "|"); result != expected {
+		t.Errorf("Expected stable sort %q, got %q", expected, result)
+	}
+}
+
+func TestTableSortKeepsSeparators(t *testing.T) {
+	table := NewTable()
+	table.AddRow("b")
+	table.AddRow("a")
+	table.AddSeparator()
+	table.AddRow("d")
+	table.AddRow("c")
+
+	table.SortBy(0, SortAscending)
+	expected := "a|b|---|c|d"
+	if result := strings.Join(columnValues(table, 0), "|"); result != expected {
+		t.Errorf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestComparators(t *testing.T) {
+	tests := []struct {
+		name    string
+		compare Comparator
+		values  []string
+		sorted  string
+	}{
+		{"numeric", CompareNumeric, []string{"$1,250.00", "n/a", "-3", "42%", "3.5 GiB"}, "-3|3.5 GiB|42%|$1,250.00|n/a"},
+		{"date", CompareDate, []string{"2024-05-01", "unknown", "2023-12-31 23:59:59", "Jan 2, 2024"}, "2023-12-31 23:59:59|Jan 2, 2024|2024-05-01|unknown"},
+		{"duration", CompareDuration, []string{"1m30s", "2.5s", "-", "1h"}, "2.5s|1m30s|1h|-"},
+		{"string", CompareString, []string{"b", "B", "a"}, "B|a|b"},
+		{"natural", CompareNatural, []string{"v1.10", "v1.9", "v1.09a"}, "v1.9|v1.09a|v1.10"},
+	}
+
+	for _, tt := range tests {
+		table := NewTable()
+		for _, value := range tt.values {
+			table.AddRow(value)
+		}
+		table.SortByFunc(0, SortAscending, tt.compare)
+
+		if result := strings.Join(columnValues(table, 0), "|"); result != tt.sorted {
+			t.Errorf("%s: expected %q, got %q", tt.name, tt.sorted, result)
+		}
+	}
+}
+
+func TestTableFilter(t *testing.T) {
+	table := NewTable()
+	table.AddRow("api", "running")
+	table.AddSeparator()
+	table.AddRow("db", "stopped")
+	table.AddSeparator()
+	table.AddRow("cache", "running")
+	table.AddSeparator()
+	table.AddRow("queue", "stopped")
+
+	table.Filter(func(row []string) bool {
+		return row[1] == "running"
+	})
+
+	expected := "api|---|cache"
+	if result := strings.Join(columnValues(table, 0), "|"); result != expected {
+		t.Errorf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestTableGroupBy(t *testing.T) {
+	table := NewTable()
+	table.SetHeaders("Category", "Item")
+	table.AddRow("Fruits", "Apple")
+	table.AddRow("Vegetables", "Carrot")
+	table.AddSeparator()
+	table.AddRow("Fruits", "Banana")
+
+	table.GroupBy(0)
+
+	categories := columnValues(table, 0)
+	items := columnValues(table, 1)
+	result := []string{}
+	for i := range items {
+		result = append(result, categories[i]+"/"+items[i])
+	}
+
+	expected := "Fruits/|Fruits/Apple|Fruits/Banana|---/---|Vegetables/|Vegetables/Carrot"
+	if strings.Join(result, "|") != expected {
+		t.Errorf("Expected %q, got %q", expected, strings.Join(result, "|"))
+	}
+
+	if !strings.Contains(table.String(), "Vegetables") {
+		t.Error("Expected grouped table to render")
+	}
+}
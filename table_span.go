@@ -0,0 +1,303 @@
+// table_span.go
+package colorbear
+
+import "strings"
+
+// Rowspan lets the cell span several data rows. The covered columns are
+// skipped when the cells of the following rows are assigned to columns.
+// Separators do not count as rows; a cell spanning across one interrupts
+// the separator line.
+//
+// Example:
+//
+//	table.AddCells(colorbear.NewCell("Europe").Rowspan(2), colorbear.NewCell("Berlin"))
+//	table.AddRow("Paris") // Placed in the second column
+func (c *Cell) Rowspan(rows int) *Cell {
+	c.rowspan = rows
+	return c
+}
+
+// rowSpan returns the number of rows the cell spans (at least 1).
+func (c Cell) rowSpan() int {
+	return maxInt(1, c.rowspan)
+}
+
+// WithAutoMerge merges vertically adjacent cells with the same text into
+// one cell, as if the upper cell had a rowspan. Without columns, all
+// columns are merged.
+//
+// Only single-column cells are merged, and never across separators.
+// Empty cells are not merged.
+//
+// Example:
+//
+//	table := colorbear.NewTable(colorbear.WithAutoMerge(0))
+//	table.SetHeaders("Region", "City")
+//	table.AddRow("Europe", "Berlin")
+//	table.AddRow("Europe", "Paris")
+//	// Output:
+//	// │ Region │ City   │
+//	// ├────────┼────────┤
+//	// │ Europe │ Berlin │
+//	// │        │ Paris  │
+func WithAutoMerge(columns ...int) TableOption {
+	return func(o *TableOptions) {
+		o.AutoMerge = true
+		o.MergeColumns = columns
+	}
+}
+
+// rowLayout returns the layout of every row for display: placed by
+// placeRows, with equal cells merged (see WithAutoMerge).
+func (t *Table) rowLayout(numCols int) [][]cellSlot {
+	layout := t.placeRows(numCols)
+	t.autoMerge(layout)
+	return layout
+}
+
+// placeRows places the cells of every row in numCols columns, honoring
+// column and row spans. Separators have a nil layout.
+func (t *Table) placeRows(numCols int) [][]cellSlot {
+	layout := make([][]cellSlot, len(t.rows))
+	spans := make([]cellSlot, numCols) // Cell spanning down into each column
+	remaining := make([]int, numCols)  // Rows it still covers
+
+	for r, row := range t.rows {
+		if row.separator {
+			continue
+		}
+
+		slots := []cellSlot{}
+		cells := row.cells
+		for col := 0; col < numCols; {
+			if remaining[col] > 0 {
+				slot := spans[col]
+				slot.continued = true
+				for c := slot.start; c <= slot.end; c++ {
+					remaining[c]--
+				}
+				slots = append(slots, slot)
+				col = slot.end + 1
+				continue
+			}
+
+			cell := Cell{colspan: 1}
+			if len(cells) > 0 {
+				cell, cells = cells[0], cells[1:]
+			}
+
+			// Column spans stop at columns covered from above
+			end := col
+			for end+1 < numCols && end+1 < col+cell.span() && remaining[end+1] == 0 {
+				end++
+			}
+
+			slot := cellSlot{cell: cell, start: col, end: end}
+			if rows := cell.rowSpan(); rows > 1 {
+				for c := col; c <= end; c++ {
+					spans[c] = slot
+					remaining[c] = rows - 1
+				}
+			}
+			slots = append(slots, slot)
+			col = end + 1
+		}
+		layout[r] = slots
+	}
+	return layout
+}
+
+// autoMerge marks cells equal to the cell above as its continuation (see
+// WithAutoMerge).
+func (t *Table) autoMerge(layout [][]cellSlot) {
+	if !t.options.AutoMerge {
+		return
+	}
+
+	for r := 1; r < len(layout); r++ {
+		above, below := layout[r-1], layout[r]
+		if above == nil || below == nil {
+			continue
+		}
+		for i := range below {
+			slot := &below[i]
+			if slot.continued || slot.start != slot.end || slot.cell.text == "" || !t.mergesColumn(slot.start) {
+				continue
+			}
+			upper := slotAt(above, slot.start)
+			if upper.start != upper.end || upper.cell.rowSpan() > 1 || upper.cell.text != slot.cell.text {
+				continue
+			}
+			slot.cell = upper.cell
+			slot.continued = true
+		}
+	}
+}
+
+// mergesColumn reports whether cells of a column are merged automatically.
+func (t *Table) mergesColumn(col int) bool {
+	if len(t.options.MergeColumns) == 0 {
+		return true
+	}
+	for _, c := range t.options.MergeColumns {
+		if c == col {
+			return true
+		}
+	}
+	return false
+}
+
+// slotAt returns the slot covering a column.
+func slotAt(slots []cellSlot, col int) cellSlot {
+	for _, slot := range slots {
+		if col >= slot.start && col <= slot.end {
+			return slot
+		}
+	}
+	return cellSlot{start: col, end: col}
+}
+
+// divides reports whether a row has a vertical border between two columns.
+// A nil row (outside the table) has none.
+func divides(slots []cellSlot, a, b int) bool {
+	return slots != nil && slotAt(slots, a).start != slotAt(slots, b).start
+}
+
+// buildLine creates a horizontal line between the rows above and below,
+// which are nil for the top and bottom border.
+//
+// Junctions are chosen from the borders that meet in them, so they join
+// cells that span columns (no ┬ or ┴ where cells merge), and the line is
+// left open where a cell spans rows across it.
+func (t *Table) buildLine(above, below []cellSlot, kind lineKind) string {
+	return t.colorize(t.drawLine(above, below, kind), t.options.BorderColor)
+}
+
+// drawLine creates the line for buildLine without colors. Junctions with
+// vertical lines that are not drawn (see WithBorderSides) are left out.
+func (t *Table) drawLine(above, below []cellSlot, kind lineKind) string {
+	if !t.showsLine(kind) {
+		return ""
+	}
+
+	chars := t.lineChars(kind)
+	if t.junction(above != nil, below != nil, false, true, chars) == "" {
+		return ""
+	}
+
+	open := func(col int) bool {
+		return below != nil && slotAt(below, col).continued
+	}
+
+	parts := []string{}
+	first, last := true, t.lastVisibleColumn()
+	for i, width := range t.columnWidths {
+		if t.isHidden(i) {
+			continue
+		}
+		if first && t.showsBorder(BorderLeft) {
+			parts = append(parts, t.junction(above != nil, below != nil, false, !open(i), chars))
+		}
+		first = false
+
+		segment := chars.horizontal
+		if open(i) {
+			segment = " "
+		}
+		parts = append(parts, strings.Repeat(segment, width+2*t.options.Padding))
+
+		switch {
+		case i < last && t.showsBorder(BorderInnerVertical):
+			next := t.nextVisibleColumn(i)
+			parts = append(parts, t.junction(divides(above, i, next), divides(below, i, next), !open(i), !open(next), chars))
+		case i == last && t.showsBorder(BorderRight):
+			parts = append(parts, t.junction(above != nil, below != nil, !open(i), false, chars))
+		}
+	}
+
+	return strings.Join(parts, "")
+}
+
+// junction returns the border character joining lines in the given
+// directions. Crossings and the ends of lines inside the table use the
+// characters of the line; lines going only left and right continue as
+// its horizontal.
+func (t *Table) junction(up, down, left, right bool, chars lineChars) string {
+	s := t.style
+	switch {
+	case up && down && left && right:
+		return chars.cross
+	case down && left && right:
+		return chars.down
+	case up && left && right:
+		return chars.up
+	case up && down && right:
+		return chars.left
+	case up && down && left:
+		return chars.right
+	case down && right:
+		return s.TopLeft
+	case down && left:
+		return s.TopRight
+	case up && right:
+		return s.BottomLeft
+	case up && left:
+		return s.BottomRight
+	case up || down:
+		return s.Vertical
+	case left || right:
+		return chars.horizontal
+	default:
+		return strings.Repeat(" ", visualWidth(s.Vertical))
+	}
+}
+
+// nextVisibleColumn returns the index of the next column that is not
+// hidden, or -1 if there is none.
+func (t *Table) nextVisibleColumn(col int) int {
+	for i := col + 1; i < len(t.columnWidths); i++ {
+		if !t.isHidden(i) {
+			return i
+		}
+	}
+	return -1
+}
+
+// headerSlots returns the layout of the header, or nil if it is not shown.
+func (t *Table) headerSlots() []cellSlot {
+	if !t.options.ShowHeader || len(t.headers) == 0 {
+		return nil
+	}
+	return t.cellSlots(textCells(t.headers))
+}
+
+// footerSlots returns the layout of the footer, or nil if there is none.
+func (t *Table) footerSlots() []cellSlot {
+	footer := t.footerCells()
+	if len(footer) == 0 {
+		return nil
+	}
+	return t.cellSlots(textCells(footer))
+}
+
+// bodySlots returns the layout of the first data row found from row
+// index from in direction step (1 or -1), or nil if there is none.
+func bodySlots(layout [][]cellSlot, from, step int) []cellSlot {
+	for r := from; r >= 0 && r < len(layout); r += step {
+		if layout[r] != nil {
+			return layout[r]
+		}
+	}
+	return nil
+}
+
+// firstSlots returns the first non-nil layout, or a plain row if all are
+// nil.
+func (t *Table) firstSlots(layouts ...[]cellSlot) []cellSlot {
+	for _, slots := range layouts {
+		if slots != nil {
+			return slots
+		}
+	}
+	return t.cellSlots(nil)
+}
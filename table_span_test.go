@@ -0,0 +1,113 @@
+package colorbear
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTableColspanJunctions(t *testing.T) {
+	table := NewTable()
+	table.SetHeaders("A", "B", "C")
+	table.AddCells(NewCell("Section").Colspan(3))
+	table.AddSeparator()
+	table.AddRow("1", "2", "3")
+	table.AddCells(NewCell("4"), NewCell("wide").Colspan(2))
+
+	expected := "" +
+		"╭───┬───┬───╮\n" +
+		"│ A │ B │ C │\n" +
+		"├───┴───┴───┤\n" +
+		"│ Section   │\n" +
+		"├───┬───┬───┤\n" +
+		"│ 1 │ 2 │ 3 │\n" +
+		"│ 4 │ wide  │\n" +
+		"╰───┴───────╯\n"
+	if output := table.String(); output != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, output)
+	}
+}
+
+func TestTableRowspan(t *testing.T) {
+	table := NewTable(WithTableStyle(TableStyleSimple))
+	table.SetHeaders("Region", "City")
+	table.AddCells(NewCell("Europe").Rowspan(3), NewCell("Berlin"))
+	table.AddRow("Paris")
+	table.AddSeparator()
+	table.AddRow("Rome")
+	table.AddRow("Tokyo", "Asia")
+
+	expected := "" +
+		"+--------+--------+\n" +
+		"| Region | City   |\n" +
+		"+--------+--------+\n" +
+		"| Europe | Berlin |\n" +
+		"|        | Paris  |\n" +
+		"|        +--------+\n" +
+		"|        | Rome   |\n" +
+		"| Tokyo  | Asia   |\n" +
+		"+--------+--------+\n"
+	if output := table.String(); output != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, output)
+	}
+}
+
+func TestTableAutoMerge(t *testing.T) {
+	table := NewTable(WithAutoMerge(0))
+	table.SetHeaders("Region", "City")
+	table.AddRow("Europe", "Berlin")
+	table.AddRow("Europe", "Berlin")
+	table.AddSeparator()
+	table.AddRow("Europe", "Paris")
+
+	output := table.String()
+	expected := []string{
+		"│ Europe │ Berlin │\n│        │ Berlin │",
+		"├────────┼────────┤\n│ Europe │ Paris  │",
+	}
+	for _, part := range expected {
+		if !strings.Contains(output, part) {
+			t.Errorf("Expected output to contain %q:\n%s", part, output)
+		}
+	}
+
+	// Merging is for display only
+	if csv := table.CSV(); !strings.Contains(csv, "Europe,Berlin\r\nEurope,Berlin") {
+		t.Errorf("Expected exports to keep merged values, got %q", csv)
+	}
+}
+
+func TestTableSpanExport(t *testing.T) {
+	table := NewTable()
+	table.SetHeaders("A", "B", "C")
+	table.AddCells(NewCell("x").Rowspan(2), NewCell("y").Colspan(2))
+	table.AddRow("1", "2")
+
+	expected := "A,B,C\r\nx,y,\r\n,1,2\r\n"
+	if csv := table.CSV(); csv != expected {
+		t.Errorf("Expected %q, got %q", expected, csv)
+	}
+}
+
+func TestTableJunction(t *testing.T) {
+	table := NewTable()
+
+	tests := []struct {
+		up, down, left, right bool
+		expected              string
+	}{
+		{true, true, true, true, "┼"},
+		{false, true, true, true, "┬"},
+		{true, false, true, true, "┴"},
+		{true, true, false, true, "├"},
+		{true, true, true, false, "┤"},
+		{false, false, true, true, "─"},
+		{true, true, false, false, "│"},
+		{false, false, false, false, " "},
+	}
+
+	for _, tt := range tests {
+		if result := table.junction(tt.up, tt.down, tt.left, tt.right, table.lineChars(rowLine)); result != tt.expected {
+			t.Errorf("Expected %q for %v, got %q", tt.expected, tt, result)
+		}
+	}
+}
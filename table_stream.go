@@ -0,0 +1,225 @@
+// table_stream.go
+package colorbear
+
+import (
+	"errors"
+	"io"
+	"os"
+	"strings"
+)
+
+// ErrTableWriterClosed is returned when rows are added to a closed
+// TableWriter.
+var ErrTableWriterClosed = errors.New("colorbear: table writer is closed")
+
+// WithSampleRows sets how many rows a streaming table buffers to compute
+// its column widths (see Stream). The default of 0 takes the widths from
+// the headers and writes every row immediately.
+//
+// Example:
+//
+//	table := colorbear.NewTable(colorbear.WithSampleRows(50))
+func WithSampleRows(rows int) TableOption {
+	return func(o *TableOptions) {
+		o.SampleRows = rows
+	}
+}
+
+// TableWriter writes the rows of a table to an io.Writer as they are
+// added, for tables too large to buffer or with rows arriving over time.
+//
+// Column widths are fixed before the first row is written: from the fixed
+// widths (WithColumnWidths or Column.Width) if set, otherwise from the
+// headers and the first rows (see WithSampleRows), within the limits of
+// MinWidth, MaxWidth and the table width. Later rows that are wider are
+// wrapped or truncated (see WithOverflow).
+//
+// Written rows are not kept. Row spans, auto-merging and summaries
+// (SetSummary) need all rows and are not supported; a footer set with
+// SetFooter is written by Close.
+//
+// Errors from the writer are sticky: after the first error, no more
+// output is written and every method returns that error.
+type TableWriter struct {
+	table   *Table
+	started bool       // Whether widths are fixed and the top was written
+	closed  bool       // Whether Close was called
+	rows    int        // Rows written, for alternating row colors
+	last    []cellSlot // Layout of the last row, for the next line
+	err     error
+}
+
+// Stream returns a TableWriter that writes the table to w.
+//
+// The headers, columns, title and options of the table are used; rows
+// already added are written first. Colors are used if w is a terminal
+// (see ForceColors). Add rows to the TableWriter, not to the table, and
+// call Close when done. The TableWriter works on a copy of the table, so
+// the table itself is left unchanged and keeps printing to stdout.
+//
+// Example:
+//
+//	table := colorbear.NewTable(colorbear.WithSampleRows(20))
+//	table.SetHeaders("Time", "Level", "Message")
+//
+//	stream := table.Stream(os.Stdout)
+//	for entry := range entries {
+//	    if err := stream.AddRow(entry.Time, entry.Level, entry.Message); err != nil {
+//	        return err
+//	    }
+//	}
+//	return stream.Close()
+func (t *Table) Stream(w io.Writer) *TableWriter {
+	stream := *t
+	stream.writer = w
+	stream.columnWidths = []int{}
+	stream.rows = append([]tableRow(nil), t.rows...)
+	return &TableWriter{table: &stream}
+}
+
+// AddRow writes a row, or buffers it until the sample rows are complete.
+func (tw *TableWriter) AddRow(cells ...string) error {
+	return tw.add(tableRow{cells: textCells(cells)})
+}
+
+// AddCells writes a row of cells (see NewCell). Column spans are
+// supported, row spans are ignored.
+func (tw *TableWriter) AddCells(cells ...*Cell) error {
+	row := tableRow{cells: make([]Cell, len(cells))}
+	for i, cell := range cells {
+		if cell != nil {
+			row.cells[i] = *cell
+			row.cells[i].rowspan = 1
+		}
+	}
+	return tw.add(row)
+}
+
+// AddSeparator writes a separator line.
+func (tw *TableWriter) AddSeparator() error {
+	return tw.add(tableRow{separator: true})
+}
+
+// Flush fixes the column widths and writes the buffered rows, without
+// waiting for the sample rows to be complete.
+func (tw *TableWriter) Flush() error {
+	if tw.err == nil && !tw.closed && !tw.started {
+		tw.start()
+	}
+	return tw.err
+}
+
+// Close writes the buffered rows, the footer, the bottom border and the
+// caption. Calling Close again has no effect.
+func (tw *TableWriter) Close() error {
+	if tw.closed || tw.err != nil {
+		return tw.err
+	}
+	if !tw.started {
+		tw.start()
+	}
+	tw.closed = true
+
+	t := tw.table
+	var output strings.Builder
+	above := t.firstSlots(tw.last, t.headerSlots())
+	if len(t.footer) > 0 {
+		footer := t.cellSlots(textCells(t.footer))
+		t.writeFooterSeparator(&output, above, footer)
+		output.WriteString(t.buildRow(footerRow, footer, t.options.FooterColor))
+		output.WriteString("\n")
+		above = footer
+	}
+	t.writeBottomBorder(&output, above)
+	t.writeCaption(&output, t.tableWidth())
+	tw.write(output.String())
+	return tw.err
+}
+
+// add buffers a row until the widths are fixed, then writes it.
+func (tw *TableWriter) add(row tableRow) error {
+	switch {
+	case tw.err != nil:
+		return tw.err
+	case tw.closed:
+		return ErrTableWriterClosed
+	}
+
+	t := tw.table
+	if !tw.started && len(t.rows) < tw.sampleRows() {
+		t.rows = append(t.rows, row)
+		if len(t.rows) == tw.sampleRows() {
+			tw.start()
+		}
+		return tw.err
+	}
+	if !tw.started {
+		if tw.start(); tw.err != nil {
+			return tw.err
+		}
+	}
+
+	if row.separator && t.options.HiddenBorders&BorderInnerHorizontal != 0 {
+		tw.rows++ // Keeps row colors in step with String
+		return nil
+	}
+
+	var output strings.Builder
+	if row.separator {
+		output.WriteString(t.buildLine(t.firstSlots(tw.last, t.headerSlots()), t.cellSlots(nil), rowLine))
+	} else {
+		tw.last = t.cellSlots(row.cells)
+		output.WriteString(t.buildRow(bodyRow, tw.last, t.getRowColor(tw.rows)+styleCodes(row.style)))
+	}
+	output.WriteString("\n")
+	tw.rows++
+	tw.write(output.String())
+	return tw.err
+}
+
+// sampleRows returns the number of rows to buffer. Without headers or
+// fixed widths, at least the first row is needed to know the columns.
+func (tw *TableWriter) sampleRows() int {
+	t := tw.table
+	if len(t.headers) == 0 && len(t.options.ColumnWidths) == 0 {
+		return maxInt(1, t.options.SampleRows)
+	}
+	return t.options.SampleRows
+}
+
+// start fixes the column widths and writes the title, the top border,
+// the header and the buffered rows.
+func (tw *TableWriter) start() {
+	t := tw.table
+	tw.started = true
+
+	t.calculateColumnWidths()
+	layout := t.rowLayout(len(t.columnWidths))
+
+	var output strings.Builder
+	t.writeTitle(&output)
+	t.writeTopBorder(&output, layout)
+	t.writeHeader(&output, layout)
+	t.writeRows(&output, layout)
+
+	tw.rows = len(t.rows)
+	tw.last = bodySlots(layout, len(layout)-1, -1)
+	t.rows = []tableRow{}
+	tw.write(output.String())
+}
+
+// write writes output unless an error occurred before.
+func (tw *TableWriter) write(output string) {
+	if tw.err == nil && output != "" {
+		_, tw.err = io.WriteString(tw.table.writer, output)
+	}
+}
+
+// outputWriter returns where the table is written to: the writer of a
+// streaming table, or stdout.
+func (t *Table) outputWriter() io.Writer {
+	if t.writer != nil {
+		return t.writer
+	}
+	return os.Stdout
+}
@@ -0,0 +1,134 @@
+package colorbear
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestTableStreamMatchesString(t *testing.T) {
+	newTable := func() *Table {
+		table := NewTable(WithSampleRows(2))
+		table.SetTitle("Log")
+		table.SetHeaders("Level", "Message")
+		table.SetFooter("", "end")
+		table.SetCaption("3 entries")
+		return table
+	}
+
+	expected := newTable()
+	expected.AddRow("info", "started")
+	expected.AddRow("warning", "slow")
+	expected.AddSeparator()
+	expected.AddRow("info", "done")
+
+	var buf bytes.Buffer
+	stream := newTable().Stream(&buf)
+	stream.AddRow("info", "started")
+	stream.AddRow("warning", "slow")
+	stream.AddSeparator()
+	stream.AddRow("info", "done")
+	if err := stream.Close(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if buf.String() != expected.String() {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected.String(), buf.String())
+	}
+}
+
+func TestTableStreamWritesRows(t *testing.T) {
+	var buf bytes.Buffer
+	table := NewTable(WithSampleRows(2))
+	table.SetHeaders("ID", "Name")
+	stream := table.Stream(&buf)
+
+	stream.AddRow("1", "first")
+	if buf.Len() != 0 {
+		t.Errorf("Expected rows to be buffered until the sample is complete, got:\n%s", buf.String())
+	}
+
+	stream.AddRow("2", "second")
+	if !strings.Contains(buf.String(), "│ 2  │ second │") {
+		t.Errorf("Expected sample rows to be written, got:\n%s", buf.String())
+	}
+
+	// Wider rows are wrapped to the fixed widths
+	stream.AddRow("3", "the third row")
+	if !strings.Contains(buf.String(), "│ 3  │ the    │") {
+		t.Errorf("Expected wide row to be wrapped, got:\n%s", buf.String())
+	}
+	if len(stream.table.rows) != 0 {
+		t.Errorf("Expected written rows not to be kept, got %d", len(stream.table.rows))
+	}
+
+	stream.Close()
+	if !strings.HasSuffix(buf.String(), "╰────┴────────╯\n") {
+		t.Errorf("Expected bottom border on Close, got:\n%s", buf.String())
+	}
+}
+
+func TestTableStreamWidthsFromHeaders(t *testing.T) {
+	var buf bytes.Buffer
+	table := NewTable(WithColumnWidths(4, 6))
+	stream := table.Stream(&buf)
+
+	stream.AddRow("1", "x")
+	expected := "╭──────┬────────╮\n│ 1    │ x      │\n"
+	if buf.String() != expected {
+		t.Errorf("Expected fixed widths and immediate output, got:\n%s", buf.String())
+	}
+}
+
+func TestTableStreamLeavesTableUnchanged(t *testing.T) {
+	ForceColors(false)
+	table := NewTable(WithHeaderColor(Bold))
+	table.SetHeaders("ID", "Name")
+	table.AddRow("1", "first")
+	before := table.String()
+
+	var buf bytes.Buffer
+	stream := table.Stream(&buf)
+	stream.AddRow("2", "the second row")
+	stream.Close()
+
+	if table.writer != nil || table.outputWriter() != os.Stdout {
+		t.Errorf("Expected the table to keep writing to stdout, got %v", table.outputWriter())
+	}
+	if len(table.rows) != 1 {
+		t.Errorf("Expected the table to keep its rows, got %d", len(table.rows))
+	}
+	if after := table.String(); after != before {
+		t.Errorf("Expected String to be unchanged after streaming:\n%s\ngot:\n%s", before, after)
+	}
+}
+
+type failingWriter struct{ writes int }
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	w.writes++
+	return 0, errors.New("disk full")
+}
+
+func TestTableStreamErrors(t *testing.T) {
+	w := &failingWriter{}
+	table := NewTable()
+	table.SetHeaders("A")
+	stream := table.Stream(w)
+
+	if err := stream.AddRow("1"); err == nil || err.Error() != "disk full" {
+		t.Errorf("Expected writer error, got %v", err)
+	}
+	if err := stream.AddRow("2"); err == nil || w.writes != 1 {
+		t.Errorf("Expected sticky error without further writes, got %v after %d writes", err, w.writes)
+	}
+
+	var buf bytes.Buffer
+	stream = NewTable().Stream(&buf)
+	stream.Close()
+	if err := stream.AddRow("late"); !errors.Is(err, ErrTableWriterClosed) {
+		t.Errorf("Expected ErrTableWriterClosed, got %v", err)
+	}
+}
@@ -0,0 +1,212 @@
+// table_summary.go
+package colorbear
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Aggregate computes a summary cell from the cells of a column.
+//
+// It receives the cells of all data rows as they were added (including
+// color codes). Use one of the predefined aggregates or write your own.
+type Aggregate func(cells []string) string
+
+// SetSummary sets a footer computed from the data rows, one aggregate per
+// column. Columns without an aggregate (or with nil) stay empty.
+//
+// The summary is computed whenever the table is rendered or exported, so
+// rows added later are included. It replaces a footer set with SetFooter.
+// Numbers are formatted like the cells they were computed from: currency
+// symbols, units, thousands separators and decimals are kept.
+//
+// Example:
+//
+//	table.SetHeaders("Item", "Qty", "Price")
+//	table.AddRow("Coffee", "2", "$1,250.00")
+//	table.AddRow("Cake", "1", "$3.50")
+//	table.SetSummary(colorbear.Label("Total"), colorbear.Sum(), colorbear.Sum())
+//	// Footer: │ Total  │ 3   │ $1,253.50 │
+func (t *Table) SetSummary(aggregates ...Aggregate) *Table {
+	t.summary = aggregates
+	t.footer = []string{}
+	t.columnWidths = []int{}
+	return t
+}
+
+// Label returns an aggregate that always shows the given text.
+func Label(text string) Aggregate {
+	return func([]string) string {
+		return text
+	}
+}
+
+// Sum returns an aggregate that adds up the numbers of a column. Cells
+// that are not numbers are ignored (see CompareNumeric).
+func Sum() Aggregate {
+	return func(cells []string) string {
+		values, format, ok := columnNumbers(cells)
+		if !ok {
+			return ""
+		}
+		sum := 0.0
+		for _, value := range values {
+			sum += value
+		}
+		return format.format(sum)
+	}
+}
+
+// Avg returns an aggregate that shows the average of the numbers of a
+// column, with at least two decimals.
+func Avg() Aggregate {
+	return func(cells []string) string {
+		values, format, ok := columnNumbers(cells)
+		if !ok {
+			return ""
+		}
+		sum := 0.0
+		for _, value := range values {
+			sum += value
+		}
+		format.decimals = maxInt(format.decimals, 2)
+		return format.format(sum / float64(len(values)))
+	}
+}
+
+// Min returns an aggregate that shows the cell with the smallest number.
+func Min() Aggregate {
+	return extremeCell(func(a, b float64) bool { return a < b })
+}
+
+// Max returns an aggregate that shows the cell with the largest number.
+func Max() Aggregate {
+	return extremeCell(func(a, b float64) bool { return a > b })
+}
+
+// Count returns an aggregate that counts the non-empty cells of a column.
+func Count() Aggregate {
+	return func(cells []string) string {
+		count := 0
+		for _, cell := range cells {
+			if strings.TrimSpace(stripANSI(cell)) != "" {
+				count++
+			}
+		}
+		return strconv.Itoa(count)
+	}
+}
+
+// extremeCell returns an aggregate that shows the number cell for which
+// better returns true against all others.
+func extremeCell(better func(a, b float64) bool) Aggregate {
+	return func(cells []string) string {
+		result, best, found := "", 0.0, false
+		for _, cell := range cells {
+			value, ok := parseNumber(cell)
+			if ok && (!found || better(value, best)) {
+				result, best, found = strings.TrimSpace(stripANSI(cell)), value, true
+			}
+		}
+		return result
+	}
+}
+
+// footerCells returns the footer: the computed summary if set, otherwise
+// the footer set with SetFooter.
+func (t *Table) footerCells() []string {
+	if len(t.summary) == 0 {
+		return t.footer
+	}
+
+	numCols := t.determineColumnCount()
+	columns := make([][]string, numCols)
+	for _, slots := range t.placeRows(numCols) {
+		for _, slot := range slots {
+			if !slot.continued {
+				columns[slot.start] = append(columns[slot.start], slot.cell.text)
+			}
+		}
+	}
+
+	cells := make([]string, numCols)
+	for i, aggregate := range t.summary {
+		if i < numCols && aggregate != nil {
+			cells[i] = aggregate(columns[i])
+		}
+	}
+	return cells
+}
+
+// numberFormat describes how a number is written in a cell, such as
+// "$1,250.00" or "42.5 %".
+type numberFormat struct {
+	prefix   string // Currency symbol
+	suffix   string // Unit
+	decimals int    // Digits after the decimal point
+	grouping bool   // Thousands separators
+}
+
+// columnNumbers parses the number cells of a column. The format is taken
+// from the first number, with the most decimals of all numbers.
+func columnNumbers(cells []string) ([]float64, numberFormat, bool) {
+	values := []float64{}
+	format := numberFormat{}
+	for _, cell := range cells {
+		value, ok := parseNumber(cell)
+		if !ok {
+			continue
+		}
+		cellFormat := parseNumberFormat(cell)
+		if len(values) == 0 {
+			format = cellFormat
+		}
+		format.decimals = maxInt(format.decimals, cellFormat.decimals)
+		format.grouping = format.grouping || cellFormat.grouping
+		values = append(values, value)
+	}
+	return values, format, len(values) > 0
+}
+
+// parseNumberFormat extracts the format of a number cell.
+func parseNumberFormat(cell string) numberFormat {
+	s := strings.TrimPrefix(strings.TrimSpace(stripANSI(cell)), "-")
+	number := strings.TrimLeft(s, "$€£¥+")
+	format := numberFormat{prefix: strings.TrimPrefix(s[:len(s)-len(number)], "+")}
+
+	end := 0
+	for end < len(number) && strings.IndexByte("0123456789.,_", number[end]) >= 0 {
+		end++
+	}
+	format.suffix = number[end:]
+	format.grouping = strings.Contains(number[:end], ",")
+	if dot := strings.IndexByte(number[:end], '.'); dot >= 0 {
+		format.decimals = end - dot - 1
+	}
+	return format
+}
+
+// format writes a number in the format.
+func (f numberFormat) format(value float64) string {
+	sign := ""
+	if value < 0 {
+		sign = "-"
+		value = -value
+	}
+
+	number := strconv.FormatFloat(value, 'f', f.decimals, 64)
+	if f.grouping {
+		integer, fraction, _ := strings.Cut(number, ".")
+		for i := len(integer) - 3; i > 0; i -= 3 {
+			integer = integer[:i] + "," + integer[i:]
+		}
+		number = integer
+		if fraction != "" {
+			number += "." + fraction
+		}
+	}
+	if sign != "" && strings.Trim(number, "0.,") == "" {
+		sign = "" // No "-0.00"
+	}
+	return sign + f.prefix + number + f.suffix
+}
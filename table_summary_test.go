@@ -0,0 +1,105 @@
+package colorbear
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTableSetSummary(t *testing.T) {
+	table := NewTable()
+	table.SetHeaders("Item", "Qty", "Price", "CPU", "Note")
+	table.AddRow("Coffee", "2", "$1,250.00", "40%", "hot")
+	table.AddRow("Cake", "1", "$3.5", "45%", "")
+	table.AddSeparator()
+	table.AddRow("Tea", "n/a", "-$10", "\033[31m50%\033[0m", "green")
+	table.SetSummary(Label("Total"), Sum(), Sum(), Avg(), Count())
+
+	expected := []string{"Total", "3", "$1,243.50", "45.00%", "2"}
+	if result := table.footerCells(); strings.Join(result, "|") != strings.Join(expected, "|") {
+		t.Errorf("Expected summary %v, got %v", expected, result)
+	}
+
+	// Rows added later are included
+	table.AddRow("Juice", "4", "$6.50", "45%", "cold")
+	if result := table.footerCells()[1]; result != "7" {
+		t.Errorf("Expected updated sum 7, got %q", result)
+	}
+
+	if !strings.Contains(table.String(), "│ Total  │ 7   │ $1,250.00 │ 45.00% │ 3     │") {
+		t.Errorf("Expected summary as footer:\n%s", table.String())
+	}
+}
+
+func TestTableSummaryReplacesFooter(t *testing.T) {
+	table := NewTable()
+	table.AddRow("1")
+	table.SetFooter("manual")
+	table.SetSummary(Sum())
+	if result := table.footerCells(); len(result) != 1 || result[0] != "1" {
+		t.Errorf("Expected summary to replace the footer, got %v", result)
+	}
+
+	table.SetFooter("manual")
+	if result := table.footerCells(); result[0] != "manual" {
+		t.Errorf("Expected footer to replace the summary, got %v", result)
+	}
+}
+
+func TestAggregates(t *testing.T) {
+	cells := []string{"€1,200", "-3", "n/a", "\033[32m€7\033[0m", ""}
+
+	tests := []struct {
+		name      string
+		aggregate Aggregate
+		expected  string
+	}{
+		{"sum", Sum(), "€1,204"},
+		{"avg", Avg(), "€401.33"},
+		{"min", Min(), "-3"},
+		{"max", Max(), "€1,200"},
+		{"count", Count(), "4"},
+		{"label", Label("Total"), "Total"},
+	}
+
+	for _, tt := range tests {
+		if result := tt.aggregate(cells); result != tt.expected {
+			t.Errorf("%s: expected %q, got %q", tt.name, tt.expected, result)
+		}
+	}
+
+	if result := Sum()([]string{"n/a"}); result != "" {
+		t.Errorf("Expected empty sum without numbers, got %q", result)
+	}
+}
+
+func TestNumberFormat(t *testing.T) {
+	tests := []struct {
+		cell     string
+		value    float64
+		expected string
+	}{
+		{"$1,250.00", 1234567.891, "$1,234,567.89"},
+		{"3.5 GiB", 10, "10.0 GiB"},
+		{"42%", -0.4, "0%"},
+		{"-$3", -12, "-$12"},
+		{"+7", 8, "8"},
+	}
+
+	for _, tt := range tests {
+		if result := parseNumberFormat(tt.cell).format(tt.value); result != tt.expected {
+			t.Errorf("Expected %q for %q, got %q", tt.expected, tt.cell, result)
+		}
+	}
+}
+
+func TestTableSummaryAfterRender(t *testing.T) {
+	table := NewTable()
+	table.SetHeaders("Item", "Qty")
+	table.AddRow("Tea", "1")
+	_ = table.String() // Caches the column widths
+
+	table.SetSummary(Label("Total amount"), Sum())
+	if output := table.String(); !strings.Contains(output, "│ Total amount │ 1   │") {
+		t.Errorf("Expected the summary to widen the column, got:\n%s", output)
+	}
+}
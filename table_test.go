@@ -35,6 +35,10 @@ func TestTableStyles(t *testing.T) {
 		TableStyleBold,
 		TableStyleMinimal,
 		TableStyleCompact,
+		TableStyleMarkdown,
+		TableStyleHeavyHeader,
+		TableStyleDashed,
+		TableStyleBlock,
 	}
 
 	for _, style := range styles {
@@ -205,3 +209,45 @@ func TestTableOptions(t *testing.T) {
 		t.Error("AutoSize option not applied")
 	}
 }
+
+func TestVisualWidthUnicode(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected int
+	}{
+		{"abc", 3},
+		{"✓ ok", 4},
+		{"\x1b[32m✓\x1b[0m", 1},
+		{"🕐", 2},
+		{"日本", 4},
+	}
+
+	for _, tt := range tests {
+		if got := visualWidth(tt.text); got != tt.expected {
+			t.Errorf("visualWidth(%q) = %d, expected %d", tt.text, got, tt.expected)
+		}
+	}
+}
+
+func TestTableChangesAfterRender(t *testing.T) {
+	table := NewTable()
+	table.SetHeaders("Item", "Qty")
+	table.AddRow("Tea", "1")
+	_ = table.String() // Caches the column widths
+
+	table.AddRow("Green tea leaves", "2")
+	table.SetFooter("Total amount", "3")
+	table.AddCells(NewCell("Gift wrapping"), NewCell("1"))
+	output := table.String()
+
+	for _, expected := range []string{"│ Green tea leaves │", "│ Total amount     │", "│ Gift wrapping    │"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected %q after adding rows, got:\n%s", expected, output)
+		}
+	}
+
+	table.SetHeaders("Item description", "Quantity")
+	if output := table.String(); !strings.Contains(output, "│ Item description │ Quantity │") {
+		t.Errorf("Expected new headers to widen the columns, got:\n%s", output)
+	}
+}
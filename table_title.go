@@ -0,0 +1,140 @@
+// table_title.go
+package colorbear
+
+import "strings"
+
+// TitlePosition controls where the table title is drawn.
+type TitlePosition int
+
+const (
+	TitleInBorder TitlePosition = iota // Inside the top border (default)
+	TitleAbove                         // On its own line above the table
+)
+
+// WithTitlePosition sets where the title is drawn. Tables without a top
+// border always draw the title above the table.
+//
+// Example:
+//
+//	table := colorbear.NewTable(colorbear.WithTitlePosition(colorbear.TitleAbove))
+func WithTitlePosition(position TitlePosition) TableOption {
+	return func(o *TableOptions) {
+		o.TitlePosition = position
+	}
+}
+
+// WithTitleAlign sets the alignment of the title (default: left).
+//
+// Example:
+//
+//	colorbear.WithTitleAlign(colorbear.AlignCenter) // ╭──── Deployments ────╮
+func WithTitleAlign(alignment Alignment) TableOption {
+	return func(o *TableOptions) {
+		o.TitleAlign = alignment
+	}
+}
+
+// WithTitleColor sets the color of the title.
+//
+// Example:
+//
+//	colorbear.WithTitleColor(colorbear.Bold + colorbear.CyanCode)
+func WithTitleColor(color string) TableOption {
+	return func(o *TableOptions) {
+		o.TitleColor = color
+	}
+}
+
+// SetTitle sets the title of the table.
+//
+// By default the title is drawn inside the top border. Titles that do not
+// fit are truncated.
+//
+// Example:
+//
+//	table.SetTitle("Deployments")
+//	// Output:
+//	// ╭─ Deployments ──────────╮
+//	// │ Service │ Status       │
+func (t *Table) SetTitle(title string) *Table {
+	t.title = title
+	return t
+}
+
+// SetCaption sets a caption shown below the table. Long captions are
+// wrapped to the width of the table.
+//
+// Example:
+//
+//	table.SetCaption("Last updated 5 minutes ago")
+func (t *Table) SetCaption(caption string) *Table {
+	t.caption = caption
+	return t
+}
+
+// titleInBorder reports whether the title is drawn inside the top border.
+func (t *Table) titleInBorder() bool {
+	return t.options.TitlePosition == TitleInBorder && t.showsLine(topLine)
+}
+
+// writeTitle writes the title above the table, unless it is drawn inside
+// the top border.
+func (t *Table) writeTitle(output *strings.Builder) {
+	if t.title == "" || t.titleInBorder() {
+		return
+	}
+	title := t.alignText(singleLine(t.title), t.tableWidth(), t.options.TitleAlign)
+	output.WriteString(t.colorize(strings.TrimRight(title, " "), t.options.TitleColor))
+	output.WriteString("\n")
+}
+
+// titleBorder draws the title into a top border line (without colors).
+// One horizontal line character is kept on each side of the title.
+func (t *Table) titleBorder(line string) string {
+	border := []rune(line) // Border characters are one column wide
+	space := len(border) - 4
+	if t.title == "" || space < 3 {
+		return t.colorize(line, t.options.BorderColor)
+	}
+
+	text := singleLine(t.title)
+	if visualWidth(text) > space-2 {
+		text = truncateLine(text, space-2)
+	}
+	text = " " + text + " "
+	width := visualWidth(text)
+
+	offset := 2
+	switch t.options.TitleAlign {
+	case AlignCenter:
+		offset = (len(border) - width) / 2
+	case AlignRight:
+		offset = len(border) - width - 2
+	}
+
+	return t.colorize(string(border[:offset]), t.options.BorderColor) +
+		t.colorize(text, t.options.TitleColor) +
+		t.colorize(string(border[offset+width:]), t.options.BorderColor)
+}
+
+// writeCaption writes the caption below the table, wrapped to width.
+func (t *Table) writeCaption(output *strings.Builder, width int) {
+	if t.caption == "" {
+		return
+	}
+	for _, line := range strings.Split(t.caption, "\n") {
+		lines := []string{line}
+		if width > 0 && visualWidth(line) > width {
+			lines = wrapLine(line, width)
+		}
+		for _, wrapped := range lines {
+			output.WriteString(wrapped)
+			output.WriteString("\n")
+		}
+	}
+}
+
+// singleLine collapses whitespace, including line breaks, to single spaces.
+func singleLine(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
@@ -0,0 +1,75 @@
+package colorbear
+
+import (
+	"strings"
+	"testing"
+)
+
+func newTitleTable(opts ...TableOption) *Table {
+	table := NewTable(opts...)
+	table.SetTitle("Deploys")
+	table.SetHeaders("Service", "Status")
+	table.AddRow("api", "running")
+	return table
+}
+
+func TestTableTitleInBorder(t *testing.T) {
+	tests := []struct {
+		name     string
+		align    Alignment
+		expected string
+	}{
+		{"left", AlignLeft, "╭─ Deploys ─────────╮"},
+		{"center", AlignCenter, "╭───── Deploys ─────╮"},
+		{"right", AlignRight, "╭───────── Deploys ─╮"},
+	}
+
+	for _, tt := range tests {
+		lines := strings.Split(newTitleTable(WithTitleAlign(tt.align)).String(), "\n")
+		if lines[0] != tt.expected {
+			t.Errorf("%s: expected %q, got %q", tt.name, tt.expected, lines[0])
+		}
+		if visualWidth(lines[0]) != visualWidth(lines[1]) {
+			t.Errorf("%s: expected title border as wide as the table, got %q", tt.name, lines[0])
+		}
+	}
+}
+
+func TestTableTitleTruncated(t *testing.T) {
+	table := newTitleTable()
+	table.SetTitle("A title that is much longer than the table")
+
+	lines := strings.Split(table.String(), "\n")
+	if lines[0] != "╭─ A title that i… ─╮" {
+		t.Errorf("Expected truncated title, got %q", lines[0])
+	}
+}
+
+func TestTableTitleAbove(t *testing.T) {
+	output := newTitleTable(WithTitlePosition(TitleAbove), WithTitleAlign(AlignCenter)).String()
+	if !strings.HasPrefix(output, "       Deploys\n╭─────────┬─────────╮\n") {
+		t.Errorf("Expected centered title above the table:\n%s", output)
+	}
+
+	// Without a top border, the title goes above the table
+	output = newTitleTable(WithShowBorders(false)).String()
+	if !strings.HasPrefix(output, "Deploys\n") {
+		t.Errorf("Expected title above a borderless table:\n%s", output)
+	}
+}
+
+func TestTableCaption(t *testing.T) {
+	table := newTitleTable()
+	table.SetCaption("Updated every five minutes")
+
+	output := table.String()
+	if !strings.HasSuffix(output, "╯\nUpdated every five\nminutes\n") {
+		t.Errorf("Expected caption wrapped to the table width:\n%s", output)
+	}
+}
+
+func TestTableTitleHTML(t *testing.T) {
+	if html := newTitleTable().HTML(); !strings.Contains(html, "<caption>Deploys</caption>") {
+		t.Errorf("Expected title as HTML caption, got:\n%s", html)
+	}
+}
@@ -0,0 +1,194 @@
+// table_vertical.go
+package colorbear
+
+import (
+	"strconv"
+	"strings"
+)
+
+// VerticalMode controls when a table is drawn in the vertical layout.
+type VerticalMode int
+
+const (
+	VerticalOff  VerticalMode = iota // Always draw columns side by side (default)
+	VerticalOn                       // Always draw one block per row
+	VerticalAuto                     // Vertical if the table is wider than the terminal
+)
+
+// WithVertical sets when the table is drawn in the vertical layout, like
+// psql's \x or MySQL's \G: every row becomes a block of "header: value"
+// lines, with the values aligned and a line between the rows.
+//
+// With VerticalAuto, the vertical layout is used when the table does not
+// fit the terminal (or the width set with WithMaxTableWidth) without
+// shrinking columns. When stdout is not a terminal, the COLUMNS
+// environment variable is used; if that is not set either, the table is
+// drawn as usual.
+//
+// The vertical layout is used by String and Print (and Page). Exports,
+// Pages and Stream always use the horizontal layout.
+//
+// Example:
+//
+//	table := colorbear.NewTable(colorbear.WithVertical(colorbear.VerticalAuto))
+//	table.SetHeaders("Name", "Email", "Role")
+//	table.AddRow("Alice", "alice@example.com", "Admin")
+//	table.AddRow("Bob", "bob@example.com", "Viewer")
+//	// Output (if the terminal is too narrow):
+//	// Name:  Alice
+//	// Email: alice@example.com
+//	// Role:  Admin
+//	// ────────────────────────
+//	// Name:  Bob
+//	// Email: bob@example.com
+//	// Role:  Viewer
+func WithVertical(mode VerticalMode) TableOption {
+	return func(o *TableOptions) {
+		o.Vertical = mode
+	}
+}
+
+// isVertical reports whether the table is drawn in the vertical layout.
+func (t *Table) isVertical() bool {
+	switch t.options.Vertical {
+	case VerticalOn:
+		return true
+	case VerticalAuto:
+		limit := t.verticalLimit()
+		return limit > 0 && t.naturalWidth() > limit
+	}
+	return false
+}
+
+// verticalLimit returns the width the vertical layout wraps values to, or
+// 0 if there is no limit. In automatic mode, the terminal width is a limit
+// even without WithFitToTerminal.
+func (t *Table) verticalLimit() int {
+	limit := t.widthLimit()
+	if t.options.Vertical == VerticalAuto {
+		if width := terminalWidth(t.outputWriter()); width > 0 && (limit <= 0 || width < limit) {
+			limit = width
+		}
+	}
+	return limit
+}
+
+// naturalWidth returns the width of the horizontal table before columns
+// are shrunk to fit.
+func (t *Table) naturalWidth() int {
+	natural := *t
+	opts := *t.options // The options are shared with t
+	natural.options = &opts
+	natural.columnWidths = []int{}
+	natural.options.MaxTableWidth = 0
+	natural.options.FitToTerminal = false
+	natural.calculateColumnWidths()
+	return natural.tableWidth()
+}
+
+// verticalString draws the table in the vertical layout: the title, one
+// block per data row, the footer as a last block and the caption.
+func (t *Table) verticalString() string {
+	t.calculateColumnWidths()
+	numCols := len(t.columnWidths)
+	keys := t.verticalKeys(numCols)
+
+	keyWidth := 0
+	for col, key := range keys {
+		if !t.column(col).Hidden {
+			keyWidth = maxInt(keyWidth, visualWidth(key)+1)
+		}
+	}
+	valueWidth := 0
+	if limit := t.verticalLimit(); limit > 0 {
+		valueWidth = maxInt(limit-keyWidth-1, defaultMinFitWidth)
+	}
+
+	records := [][]string{}
+	for i, slots := range t.placeRows(numCols) {
+		if slots != nil {
+			rowColor := t.getRowColor(i) + styleCodes(t.rows[i].style)
+			records = append(records, t.verticalRecord(bodyRow, slots, keys, keyWidth, valueWidth, rowColor))
+		}
+	}
+	if footer := t.footerCells(); len(footer) > 0 {
+		slots := t.cellSlots(textCells(footer))
+		records = append(records, t.verticalRecord(footerRow, slots, keys, keyWidth, valueWidth, t.options.FooterColor))
+	}
+
+	width := keyWidth
+	for _, record := range records {
+		for _, line := range record {
+			width = maxInt(width, visualWidth(line))
+		}
+	}
+
+	separator := ""
+	if t.showsLine(rowLine) {
+		separator = t.colorize(strings.Repeat(t.lineChars(rowLine).horizontal, width), t.options.BorderColor)
+	}
+
+	var output strings.Builder
+	if t.title != "" {
+		output.WriteString(t.colorize(singleLine(t.title), t.options.TitleColor))
+		output.WriteString("\n")
+	}
+	for i, record := range records {
+		if i > 0 {
+			output.WriteString(separator)
+			output.WriteString("\n")
+		}
+		for _, line := range record {
+			output.WriteString(line)
+			output.WriteString("\n")
+		}
+	}
+	t.writeCaption(&output, width)
+
+	return output.String()
+}
+
+// verticalKeys returns the key of every column: its header, or "Column N"
+// for columns without one.
+func (t *Table) verticalKeys(numCols int) []string {
+	keys := make([]string, numCols)
+	for col := range keys {
+		if col < len(t.headers) && strings.TrimSpace(t.headers[col]) != "" {
+			keys[col] = singleLine(t.headers[col])
+		} else {
+			keys[col] = "Column " + strconv.Itoa(col+1)
+		}
+	}
+	return keys
+}
+
+// verticalRecord draws the "key: value" lines of a row. Cells spanning
+// columns are shown once, under the key of their first column; cells
+// spanning rows are repeated in every row they cover. Values longer than
+// valueWidth (if set) are wrapped or truncated like cells.
+func (t *Table) verticalRecord(kind rowKind, slots []cellSlot, keys []string, keyWidth, valueWidth int, color string) []string {
+	lines := []string{}
+	indent := strings.Repeat(" ", keyWidth+1)
+	for _, slot := range slots {
+		if t.column(slot.start).Hidden {
+			continue
+		}
+
+		value := slot.cell.text
+		if kind == bodyRow {
+			value = t.applyFormat(slot.start, value)
+		}
+		value = t.colorizeCell(value, t.cellColor(kind, slot.start, slot.cell, color))
+
+		key := t.colorize(keys[slot.start]+":", t.options.HeaderColor)
+		key += strings.Repeat(" ", keyWidth-visualWidth(keys[slot.start])-1)
+		for i, line := range t.cellLines(value, slot.start, valueWidth) {
+			prefix := indent
+			if i == 0 {
+				prefix = key + " "
+			}
+			lines = append(lines, strings.TrimRight(prefix+line, " "))
+		}
+	}
+	return lines
+}
@@ -0,0 +1,143 @@
+package colorbear
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTableVertical(t *testing.T) {
+	table := NewTable(WithVertical(VerticalOn))
+	table.SetHeaders("Name", "Email")
+	table.AddRow("Alice", "alice@example.com")
+	table.AddRow("Bob", "bob@example.com")
+
+	expected := "Name:  Alice\n" +
+		"Email: alice@example.com\n" +
+		"────────────────────────\n" +
+		"Name:  Bob\n" +
+		"Email: bob@example.com\n"
+	if output := stripANSI(table.String()); output != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, output)
+	}
+}
+
+func TestTableVerticalMultiLine(t *testing.T) {
+	table := NewTable(WithVertical(VerticalOn))
+	table.SetHeaders("Name", "Notes")
+	table.AddRow("Alice", "first\nsecond")
+
+	expected := "Name:  Alice\n" +
+		"Notes: first\n" +
+		"       second\n"
+	if output := stripANSI(table.String()); output != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, output)
+	}
+}
+
+func TestTableVerticalKeysWithoutHeaders(t *testing.T) {
+	table := NewTable(WithVertical(VerticalOn), WithShowBorders(false))
+	table.AddRow("a", "b")
+	table.AddRow("c", "d")
+
+	expected := "Column 1: a\n" +
+		"Column 2: b\n" +
+		"\n" +
+		"Column 1: c\n" +
+		"Column 2: d\n"
+	if output := stripANSI(table.String()); output != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, output)
+	}
+}
+
+func TestTableVerticalFooterAndCaption(t *testing.T) {
+	table := NewTable(WithVertical(VerticalOn))
+	table.SetTitle("Orders")
+	table.SetHeaders("Item", "Qty")
+	table.AddRow("Coffee", "2")
+	table.AddRow("Cake", "1")
+	table.SetSummary(Label("Total"), Sum())
+	table.SetCaption("Today")
+
+	lines := strings.Split(strings.TrimSuffix(stripANSI(table.String()), "\n"), "\n")
+	if lines[0] != "Orders" {
+		t.Errorf("Expected title on the first line, got %q", lines[0])
+	}
+	if lines[len(lines)-1] != "Today" {
+		t.Errorf("Expected caption on the last line, got %q", lines[len(lines)-1])
+	}
+	if lines[len(lines)-2] != "Qty:  3" || lines[len(lines)-3] != "Item: Total" {
+		t.Errorf("Expected the summary as the last record, got %q", lines)
+	}
+}
+
+func TestTableVerticalSpans(t *testing.T) {
+	table := NewTable(WithVertical(VerticalOn))
+	table.SetHeaders("Region", "City", "Country")
+	table.AddCells(NewCell("Europe").Rowspan(2), NewCell("Berlin, Germany").Colspan(2))
+	table.AddRow("Paris", "France")
+
+	output := stripANSI(table.String())
+	if strings.Count(output, "Region:  Europe") != 2 {
+		t.Errorf("Expected the row-spanning cell in both records, got:\n%s", output)
+	}
+	if !strings.Contains(output, "City:    Berlin, Germany\n─") {
+		t.Errorf("Expected the column-spanning cell once, got:\n%s", output)
+	}
+}
+
+func TestTableVerticalHiddenColumn(t *testing.T) {
+	table := NewTable(WithVertical(VerticalOn))
+	table.SetColumns(Column{Header: "Name"}, Column{Header: "Secret", Hidden: true})
+	table.AddRow("Alice", "hunter2")
+
+	if output := stripANSI(table.String()); output != "Name: Alice\n" {
+		t.Errorf("Expected hidden columns to be left out, got %q", output)
+	}
+}
+
+func TestTableVerticalAuto(t *testing.T) {
+	newTable := func() *Table {
+		table := NewTable(WithVertical(VerticalAuto))
+		table.SetHeaders("Name", "Description")
+		table.AddRow("Alice", "a description that is fairly long")
+		return table
+	}
+
+	t.Setenv("COLUMNS", "200")
+	if output := stripANSI(newTable().String()); !strings.Contains(output, "│ Name") {
+		t.Errorf("Expected the horizontal layout when the table fits, got:\n%s", output)
+	}
+
+	t.Setenv("COLUMNS", "30")
+	output := stripANSI(newTable().String())
+	expected := "Name:        Alice\n" +
+		"Description: a description\n" +
+		"             that is fairly\n" +
+		"             long\n"
+	if output != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, output)
+	}
+
+	t.Setenv("COLUMNS", "")
+	if output := stripANSI(newTable().String()); !strings.Contains(output, "│ Name") {
+		t.Errorf("Expected the horizontal layout without a terminal width, got:\n%s", output)
+	}
+}
+
+func TestTableVerticalAutoRendersTwice(t *testing.T) {
+	t.Setenv("COLUMNS", "")
+	table := NewTable(WithVertical(VerticalAuto), WithMaxTableWidth(30))
+	table.SetHeaders("Name", "Description")
+	table.AddRow("Alice", "a description that is fairly long")
+
+	first := table.String()
+	if !strings.HasPrefix(stripANSI(first), "Name:") {
+		t.Errorf("Expected the vertical layout, got:\n%s", first)
+	}
+	if second := table.String(); second != first {
+		t.Errorf("Expected the same output when rendering again:\n%s\ngot:\n%s", first, second)
+	}
+	if table.options.MaxTableWidth != 30 {
+		t.Errorf("Expected the table options to stay unchanged, got width %d", table.options.MaxTableWidth)
+	}
+}
@@ -0,0 +1,277 @@
+// table_wrap.go
+package colorbear
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Overflow controls how cell text wider than its column is handled.
+//
+// Columns are narrower than their content when MaxWidth or fixed column
+// widths are set. Explicit newlines inside cells always start a new line,
+// regardless of the overflow mode.
+type Overflow int
+
+const (
+	OverflowWrap     Overflow = iota // Wrap at whitespace, hard-break long words (default)
+	OverflowTruncate                 // Cut off and end with an ellipsis (…)
+)
+
+// ellipsis marks truncated cell text.
+const ellipsis = "…"
+
+// WithOverflow sets the overflow mode for each column.
+//
+// Columns without a mode wrap their text.
+//
+// Example:
+//
+//	table := colorbear.NewTable(
+//	    colorbear.WithMaxWidth(30),
+//	    colorbear.WithOverflow(colorbear.OverflowTruncate, colorbear.OverflowWrap),
+//	)
+//	// First column: "A very long file name that…"
+//	// Second column: wrapped over several lines
+func WithOverflow(modes ...Overflow) TableOption {
+	return func(o *TableOptions) {
+		o.Overflow = modes
+	}
+}
+
+// getOverflow returns the overflow mode for a specific column.
+func (t *Table) getOverflow(col int) Overflow {
+	if col < len(t.columns) {
+		return t.columns[col].Overflow
+	}
+	if col < len(t.options.Overflow) {
+		return t.options.Overflow[col]
+	}
+	return OverflowWrap
+}
+
+// cellLines splits a cell into the lines displayed in its column.
+//
+// Explicit newlines start new lines, lines wider than the column are
+// wrapped or truncated. Colors and styles that span several lines are
+// closed at the end of each line and reopened on the next, so borders
+// are never colored by accident. width is the width of the column, or of
+// all spanned columns for cells with a colspan.
+func (t *Table) cellLines(cell string, col, width int) []string {
+	if !strings.Contains(cell, "\n") && visualWidth(cell) <= width {
+		return []string{cell}
+	}
+
+	lines := []string{}
+	for _, line := range strings.Split(strings.ReplaceAll(cell, "\r\n", "\n"), "\n") {
+		switch {
+		case width <= 0 || visualWidth(line) <= width:
+			lines = append(lines, line)
+		case t.getOverflow(col) == OverflowTruncate:
+			lines = append(lines, truncateLine(line, width))
+		default:
+			lines = append(lines, wrapLine(line, width)...)
+		}
+	}
+	return carryANSI(lines)
+}
+
+// cellWidth returns the width of the widest line of a cell.
+func cellWidth(cell string) int {
+	if !strings.Contains(cell, "\n") {
+		return visualWidth(cell)
+	}
+	width := 0
+	for _, line := range strings.Split(cell, "\n") {
+		width = maxInt(width, visualWidth(strings.TrimSuffix(line, "\r")))
+	}
+	return width
+}
+
+// ansiPiece is a part of a string: an escape sequence, a space or a
+// single visible character.
+type ansiPiece struct {
+	text  string
+	width int  // Display width (0 for escape sequences)
+	space bool // Whether the piece is whitespace
+}
+
+// splitPieces splits a string into escape sequences and characters.
+// Escape sequences end at the first letter, like in stripANSI.
+func splitPieces(s string) []ansiPiece {
+	pieces := []ansiPiece{}
+	for i := 0; i < len(s); {
+		if s[i] == 0x1b {
+			end := i + 1
+			for end < len(s) && !isASCIILetter(s[end]) {
+				end++
+			}
+			end = minInt(end+1, len(s))
+			pieces = append(pieces, ansiPiece{text: s[i:end]})
+			i = end
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(s[i:])
+		pieces = append(pieces, ansiPiece{
+			text:  s[i : i+size],
+			width: runeWidth(r),
+			space: unicode.IsSpace(r),
+		})
+		i += size
+	}
+	return pieces
+}
+
+// isASCIILetter reports whether b is an ASCII letter.
+func isASCIILetter(b byte) bool {
+	return (b >= 'A' && b <= 'Z') || (b >= 'a' && b <= 'z')
+}
+
+// wrapLine breaks a line into lines of at most width columns.
+//
+// Lines are broken at whitespace; the whitespace at a break is dropped.
+// Words longer than the width are broken where they hit the edge.
+func wrapLine(line string, width int) []string {
+	pieces := splitPieces(line)
+	lines := []string{}
+
+	var current, pending strings.Builder
+	currentWidth, pendingWidth := 0, 0
+
+	emit := func() {
+		lines = append(lines, current.String())
+		current.Reset()
+		pending.Reset()
+		currentWidth, pendingWidth = 0, 0
+	}
+
+	for i := 0; i < len(pieces); {
+		piece := pieces[i]
+		switch {
+		case piece.width == 0:
+			current.WriteString(piece.text)
+			i++
+			continue
+		case piece.space:
+			pending.WriteString(piece.text)
+			pendingWidth += piece.width
+			i++
+			continue
+		}
+
+		// Collect the whole word, including escape sequences inside it
+		end, wordWidth := i, 0
+		for end < len(pieces) && !pieces[end].space {
+			wordWidth += pieces[end].width
+			end++
+		}
+		word := pieces[i:end]
+		i = end
+
+		if currentWidth+pendingWidth+wordWidth <= width {
+			current.WriteString(pending.String())
+			currentWidth += pendingWidth
+			pending.Reset()
+			pendingWidth = 0
+			currentWidth += writePieces(&current, word)
+			continue
+		}
+
+		if currentWidth > 0 {
+			emit()
+		}
+		pending.Reset()
+		pendingWidth = 0
+
+		if wordWidth <= width {
+			currentWidth += writePieces(&current, word)
+			continue
+		}
+
+		// Hard-break the word
+		for _, p := range word {
+			if currentWidth > 0 && currentWidth+p.width > width {
+				emit()
+			}
+			current.WriteString(p.text)
+			currentWidth += p.width
+		}
+	}
+
+	if currentWidth > 0 || len(lines) == 0 {
+		lines = append(lines, current.String())
+	} else if current.Len() > 0 {
+		// Trailing escape sequences (e.g., a reset) belong to the last line
+		lines[len(lines)-1] += current.String()
+	}
+	return lines
+}
+
+// writePieces writes pieces to b and returns their total width.
+func writePieces(b *strings.Builder, pieces []ansiPiece) int {
+	width := 0
+	for _, p := range pieces {
+		b.WriteString(p.text)
+		width += p.width
+	}
+	return width
+}
+
+// truncateLine cuts a line to width columns, ending with an ellipsis.
+//
+// Escape sequences after the cut are kept, so a reset at the end of the
+// line still takes effect.
+func truncateLine(line string, width int) string {
+	var result strings.Builder
+	currentWidth := 0
+	truncated := false
+
+	for _, p := range splitPieces(line) {
+		switch {
+		case p.width == 0:
+			result.WriteString(p.text)
+		case truncated:
+			continue
+		case currentWidth+p.width > width-1:
+			result.WriteString(ellipsis)
+			truncated = true
+		default:
+			result.WriteString(p.text)
+			currentWidth += p.width
+		}
+	}
+	return result.String()
+}
+
+// carryANSI makes every line self-contained: colors and styles active at
+// the end of a line are reset there and reopened at the start of the next.
+func carryANSI(lines []string) []string {
+	active := ""
+	result := make([]string, len(lines))
+
+	for i, line := range lines {
+		result[i] = active + line
+
+		for _, p := range splitPieces(line) {
+			if p.width > 0 || !strings.HasSuffix(p.text, "m") {
+				continue
+			}
+			params := strings.TrimSuffix(strings.TrimPrefix(p.text, "\033["), "m")
+			switch {
+			case params == "" || params == "0":
+				active = ""
+			case strings.HasPrefix(params, "0;"):
+				active = p.text
+			default:
+				active += p.text
+			}
+		}
+
+		if active != "" {
+			result[i] += Reset
+		}
+	}
+	return result
+}
@@ -0,0 +1,113 @@
+package colorbear
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWrapLine(t *testing.T) {
+	tests := []struct {
+		line     string
+		width    int
+		expected []string
+	}{
+		{"the quick brown fox", 10, []string{"the quick", "brown fox"}},
+		{"supercalifragilistic", 8, []string{"supercal", "ifragili", "stic"}},
+		{"a verylongword b", 5, []string{"a", "veryl", "ongwo", "rd b"}},
+		{"  indented text", 10, []string{"  indented", "text"}},
+		{"日本語テキスト", 6, []string{"日本語", "テキス", "ト"}},
+	}
+
+	for _, tt := range tests {
+		result := wrapLine(tt.line, tt.width)
+		if strings.Join(result, "|") != strings.Join(tt.expected, "|") {
+			t.Errorf("wrapLine(%q, %d): expected %q, got %q", tt.line, tt.width, tt.expected, result)
+		}
+		for _, line := range result {
+			if visualWidth(line) > tt.width {
+				t.Errorf("wrapLine(%q, %d): line %q exceeds width", tt.line, tt.width, line)
+			}
+		}
+	}
+}
+
+func TestTruncateLine(t *testing.T) {
+	tests := []struct {
+		line     string
+		width    int
+		expected string
+	}{
+		{"hello world", 8, "hello w…"},
+		{"hello", 1, "…"},
+		{"\033[31mhello world\033[0m", 6, "\033[31mhello…\033[0m"},
+	}
+
+	for _, tt := range tests {
+		if result := truncateLine(tt.line, tt.width); result != tt.expected {
+			t.Errorf("truncateLine(%q, %d): expected %q, got %q", tt.line, tt.width, tt.expected, result)
+		}
+	}
+}
+
+func TestCarryANSI(t *testing.T) {
+	lines := wrapLine("\033[31mred text here\033[0m plain", 8)
+	result := carryANSI(lines)
+
+	expected := []string{
+		"\033[31mred text" + Reset,
+		"\033[31mhere\033[0m",
+		"plain",
+	}
+	if strings.Join(result, "|") != strings.Join(expected, "|") {
+		t.Errorf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestTableWrapsCells(t *testing.T) {
+	table := NewTable(WithMaxWidth(11))
+	table.SetHeaders("Name", "Description")
+	table.AddRow("app", "a small web application")
+
+	output := table.String()
+	lines := strings.Split(strings.TrimSuffix(output, "\n"), "\n")
+
+	// Top border, header, separator, 2 wrapped lines, bottom border
+	if len(lines) != 6 {
+		t.Fatalf("Expected 6 lines, got %d:\n%s", len(lines), output)
+	}
+
+	width := visualWidth(lines[0])
+	for _, line := range lines {
+		if visualWidth(line) != width {
+			t.Errorf("Expected all lines to be %d wide, got %d: %q", width, visualWidth(line), line)
+		}
+	}
+	if !strings.Contains(lines[3], "a small web") || !strings.Contains(lines[4], "application") {
+		t.Errorf("Expected wrapped description:\n%s", output)
+	}
+}
+
+func TestTableExplicitNewlines(t *testing.T) {
+	table := NewTable()
+	table.SetHeaders("Key", "Value")
+	table.AddRow("address", "Main St 1\n12345 Berlin")
+
+	output := table.String()
+	if !strings.Contains(output, "│ address │ Main St 1    │") ||
+		!strings.Contains(output, "│         │ 12345 Berlin │") {
+		t.Errorf("Expected multi-line cell:\n%s", output)
+	}
+}
+
+func TestTableTruncatesCells(t *testing.T) {
+	table := NewTable(
+		WithColumnWidths(6),
+		WithOverflow(OverflowTruncate),
+	)
+	table.AddRow("truncated text")
+
+	output := table.String()
+	if !strings.Contains(output, "│ trunc… │") {
+		t.Errorf("Expected truncated cell:\n%s", output)
+	}
+}
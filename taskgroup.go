@@ -0,0 +1,452 @@
+// taskgroup.go
+package colorbear
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TaskGroup displays a list of concurrent tasks, each with its own spinner.
+//
+// Every task is shown on its own line with an animated spinner frame while
+// it runs. When a task finishes, its spinner turns into ✓ or ✗ followed by
+// the elapsed time. Tasks can have nested subtasks, which are indented below
+// their parent. All lines are updated in place; once every task is done,
+// a summary line is printed.
+//
+// TaskGroup uses the same frames, colors and speed as Spinner and accepts
+// the same SpinnerOption functions.
+//
+// Example:
+//
+//	group := colorbear.NewTaskGroup()
+//	group.Start()
+//
+//	group.Go("Building image", func(t *colorbear.Task) error {
+//	    return build()
+//	})
+//	deploy := group.Add("Deploying")
+//	deploy.Add("eu-west").Done()
+//	deploy.Add("us-east").Fail(err)
+//	deploy.Done()
+//
+//	group.Wait()
+//	// Output:
+//	// ✓ Building image (4.2s)
+//	// ✓ Deploying (3.1s)
+//	//   ✓ eu-west (1.2s)
+//	//   ✗ us-east: timeout (3.0s)
+//	// ✗ 1 of 4 tasks failed in 4.2s
+type TaskGroup struct {
+	tasks     []*Task       // Top-level tasks in display order
+	frames    []string      // Animation frames for running tasks
+	color     string        // Color for the spinner frames
+	speed     time.Duration // Animation speed (time between frames)
+	writer    io.Writer     // Output writer (default: os.Stdout)
+	area      liveArea      // In-place renderer for the task list
+	frame     int           // Current frame index
+	startTime time.Time     // When Start() was called
+	running   bool          // Whether the animation is running
+	stop      chan struct{} // Closed to signal the animation goroutine to stop
+	done      chan struct{} // Closed by the animation goroutine when it has exited
+	changed   chan struct{} // Signaled whenever a task finishes (for Wait)
+	mu        sync.Mutex    // Protects all task state
+}
+
+// Task is a single entry in a TaskGroup.
+//
+// Tasks are created with TaskGroup.Add, TaskGroup.Go or Task.Add and are
+// finished with Done() or Fail(). All methods are safe for concurrent use.
+type Task struct {
+	group     *TaskGroup
+	message   string    // Text displayed next to the spinner
+	err       error     // Error passed to Fail (nil if none)
+	finished  bool      // Whether Done() or Fail() was called
+	failed    bool      // Whether the task failed
+	startTime time.Time // When the task was added
+	duration  time.Duration
+	parent    *Task // Task this is a subtask of (nil for top-level tasks)
+	depth     int   // Nesting level (0 for top-level tasks)
+	printed   bool  // Whether the task's line was printed without a terminal
+	announced bool  // Whether the task was printed as running before a subtask
+	subtasks  []*Task
+}
+
+// NewTaskGroup creates an empty task list.
+//
+// It accepts the same options as NewSpinner to customize the animation:
+//
+//	group := colorbear.NewTaskGroup(
+//	    colorbear.WithSpinnerStyle(colorbear.SpinnerCircle),
+//	    colorbear.WithSpinnerColor(colorbear.MagentaCode),
+//	)
+func NewTaskGroup(opts ...SpinnerOption) *TaskGroup {
+	template := NewSpinner("", opts...)
+
+	return &TaskGroup{
+		tasks:   []*Task{},
+		frames:  template.frames,
+		color:   template.color,
+		speed:   template.speed,
+		writer:  template.writer,
+		area:    liveArea{writer: template.writer},
+		changed: make(chan struct{}, 1),
+	}
+}
+
+// Add creates a new running task at the top level.
+//
+// Example:
+//
+//	task := group.Add("Downloading assets")
+//	download()
+//	task.Done()
+func (g *TaskGroup) Add(message string) *Task {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	task := newTask(g, message, nil)
+	g.tasks = append(g.tasks, task)
+	return task
+}
+
+// Go adds a task and runs fn for it in a new goroutine.
+//
+// The task is finished with Done() when fn returns nil and with Fail()
+// otherwise. fn may add subtasks to the task it receives.
+//
+// Example:
+//
+//	group.Go("Running tests", func(t *colorbear.Task) error {
+//	    return runTests()
+//	})
+func (g *TaskGroup) Go(message string, fn func(t *Task) error) *Task {
+	task := g.Add(message)
+	go func() {
+		if err := fn(task); err != nil {
+			task.Fail(err)
+			return
+		}
+		task.Done()
+	}()
+	return task
+}
+
+// Start begins animating the task list.
+//
+// Without a terminal (piped output, CI/CD), nothing is animated; instead
+// a line is printed for every task when it finishes. Tasks that finished
+// before Start or are still running are printed by Stop.
+func (g *TaskGroup) Start() {
+	g.mu.Lock()
+	if g.running {
+		g.mu.Unlock()
+		return // Already running
+	}
+	g.running = true
+	g.startTime = time.Now()
+	g.stop = make(chan struct{})
+	g.done = make(chan struct{})
+	stop, done := g.stop, g.done
+	g.mu.Unlock()
+
+	register(g)
+	go g.run(stop, done)
+}
+
+// run is the animation loop executed by the task group goroutine.
+func (g *TaskGroup) run(stop, done chan struct{}) {
+	defer close(done)
+	defer unregister(g)
+
+	if !g.colored() {
+		<-stop
+		return
+	}
+
+	fmt.Fprint(g.writer, hideCursor)
+	defer fmt.Fprint(g.writer, showCursor)
+
+	ticker := time.NewTicker(g.speed)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			g.mu.Lock()
+			g.frame++
+			g.area.update(nil, g.lines())
+			g.mu.Unlock()
+		}
+	}
+}
+
+// Wait blocks until all tasks are finished, then stops the group and
+// prints the summary line.
+func (g *TaskGroup) Wait() {
+	for !g.allFinished() {
+		<-g.changed
+	}
+	g.Stop()
+}
+
+// WaitContext is like Wait, but returns early with ctx.Err() when ctx
+// is done. The group is stopped in both cases.
+func (g *TaskGroup) WaitContext(ctx context.Context) error {
+	defer g.Stop()
+
+	for !g.allFinished() {
+		select {
+		case <-g.changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// Stop stops the animation, draws the final state of all tasks and prints
+// the summary line. Tasks that are still running are shown as they are.
+func (g *TaskGroup) Stop() {
+	if !g.halt() {
+		return // Not running
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.colored() {
+		g.area.update(nil, g.lines())
+	} else {
+		// Tasks that finished before Start or are still running have not
+		// been printed yet
+		g.printPending(g.tasks)
+	}
+	fmt.Fprintln(g.writer, g.summary())
+}
+
+// halt stops the animation goroutine. It returns false if the group was
+// not running.
+func (g *TaskGroup) halt() bool {
+	g.mu.Lock()
+	if !g.running {
+		g.mu.Unlock()
+		return false
+	}
+	g.running = false
+	stop, done := g.stop, g.done
+	g.mu.Unlock()
+
+	close(stop)
+	<-done
+	return true
+}
+
+// restore stops the animation without a summary (see Restore).
+func (g *TaskGroup) restore() {
+	g.halt()
+}
+
+// colored reports whether colors and cursor control are enabled for the
+// group's writer.
+func (g *TaskGroup) colored() bool {
+	return isColorEnabledFor(g.writer)
+}
+
+// allFinished reports whether every task (including subtasks) is finished.
+func (g *TaskGroup) allFinished() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	total, finished, _ := countTasks(g.tasks)
+	return total == finished
+}
+
+// lines renders all tasks depth-first. The caller must hold g.mu.
+func (g *TaskGroup) lines() []string {
+	lines := []string{}
+	var walk func(tasks []*Task, depth int)
+	walk = func(tasks []*Task, depth int) {
+		for _, task := range tasks {
+			lines = append(lines, g.taskLine(task, depth))
+			walk(task.subtasks, depth+1)
+		}
+	}
+	walk(g.tasks, 0)
+	return lines
+}
+
+// taskLine renders a single task. The caller must hold g.mu.
+func (g *TaskGroup) taskLine(task *Task, depth int) string {
+	colored := g.colored()
+	indent := strings.Repeat("  ", depth)
+	elapsed := colorizeIf(colored, "("+formatDuration(task.elapsed())+")", BrightBlack)
+
+	if !task.finished {
+		frame := colorizeIf(colored, g.frames[g.frame%len(g.frames)], g.color)
+		return fmt.Sprintf("%s%s %s %s", indent, frame, task.message, elapsed)
+	}
+
+	if task.failed {
+		message := task.message
+		if task.err != nil {
+			message += ": " + task.err.Error()
+		}
+		return fmt.Sprintf("%s%s %s", indent, errorKind.format(message, colored), elapsed)
+	}
+	return fmt.Sprintf("%s%s %s", indent, successKind.format(task.message, colored), elapsed)
+}
+
+// printTask prints the line of a task without a terminal. Ancestors that
+// were not printed yet are printed first (as running), so a subtask never
+// appears without its parent. The caller must hold g.mu.
+func (g *TaskGroup) printTask(task *Task) {
+	g.announce(task.parent)
+	fmt.Fprintln(g.writer, g.taskLine(task, task.depth))
+	task.printed = true
+}
+
+// announce prints a task and its ancestors as running, top-down, unless
+// they were printed before. The caller must hold g.mu.
+func (g *TaskGroup) announce(task *Task) {
+	if task == nil || task.printed || task.announced {
+		return
+	}
+	g.announce(task.parent)
+	fmt.Fprintln(g.writer, g.taskLine(task, task.depth))
+	task.announced = true
+}
+
+// printPending prints all tasks that were not printed yet, depth-first.
+// Running tasks that were already announced are not repeated. The caller
+// must hold g.mu.
+func (g *TaskGroup) printPending(tasks []*Task) {
+	for _, task := range tasks {
+		if !task.printed && !(task.announced && !task.finished) {
+			g.printTask(task)
+		}
+		g.printPending(task.subtasks)
+	}
+}
+
+// summary returns the final line. The caller must hold g.mu.
+func (g *TaskGroup) summary() string {
+	total, finished, failed := countTasks(g.tasks)
+	elapsed := formatDuration(time.Since(g.startTime))
+	colored := g.colored()
+
+	switch {
+	case failed > 0:
+		return errorKind.format(fmt.Sprintf("%d of %d tasks failed in %s", failed, total, elapsed), colored)
+	case finished < total:
+		return warningKind.format(fmt.Sprintf("%d of %d tasks completed in %s", finished, total, elapsed), colored)
+	default:
+		return successKind.format(fmt.Sprintf("%d tasks completed in %s", total, elapsed), colored)
+	}
+}
+
+// countTasks counts all tasks recursively. The caller must hold g.mu.
+func countTasks(tasks []*Task) (total, finished, failed int) {
+	for _, task := range tasks {
+		total++
+		if task.finished {
+			finished++
+		}
+		if task.failed {
+			failed++
+		}
+		t, f, e := countTasks(task.subtasks)
+		total, finished, failed = total+t, finished+f, failed+e
+	}
+	return total, finished, failed
+}
+
+// newTask creates a running task, as a subtask of parent if it is not nil.
+// The caller must hold g.mu.
+func newTask(g *TaskGroup, message string, parent *Task) *Task {
+	task := &Task{
+		group:     g,
+		message:   message,
+		startTime: time.Now(),
+		parent:    parent,
+		subtasks:  []*Task{},
+	}
+	if parent != nil {
+		task.depth = parent.depth + 1
+	}
+	return task
+}
+
+// Add creates a running subtask, displayed indented below this task.
+//
+// Example:
+//
+//	deploy := group.Add("Deploying")
+//	eu := deploy.Add("eu-west")
+//	eu.Done()
+func (t *Task) Add(message string) *Task {
+	t.group.mu.Lock()
+	defer t.group.mu.Unlock()
+
+	sub := newTask(t.group, message, t)
+	t.subtasks = append(t.subtasks, sub)
+	return sub
+}
+
+// UpdateMessage changes the text displayed for the task.
+func (t *Task) UpdateMessage(message string) {
+	t.group.mu.Lock()
+	defer t.group.mu.Unlock()
+	t.message = message
+}
+
+// Done marks the task as successfully finished.
+func (t *Task) Done() {
+	t.finish(nil, false)
+}
+
+// Fail marks the task as failed. If err is not nil, its message is shown
+// next to the task.
+func (t *Task) Fail(err error) {
+	t.finish(err, true)
+}
+
+// finish records the result of the task and notifies Wait.
+func (t *Task) finish(err error, failed bool) {
+	g := t.group
+	g.mu.Lock()
+	if t.finished {
+		g.mu.Unlock()
+		return
+	}
+	t.finished = true
+	t.failed = failed
+	t.err = err
+	t.duration = time.Since(t.startTime)
+
+	// Without a terminal, finished tasks are printed as they complete
+	if g.running && !g.colored() {
+		g.printTask(t)
+	}
+	g.mu.Unlock()
+
+	select {
+	case g.changed <- struct{}{}:
+	default:
+	}
+}
+
+// elapsed returns the running time, or the final duration once finished.
+// The caller must hold the group's mutex.
+func (t *Task) elapsed() time.Duration {
+	if t.finished {
+		return t.duration
+	}
+	return time.Since(t.startTime)
+}
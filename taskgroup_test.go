@@ -0,0 +1,146 @@
+package colorbear
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTaskGroupNonTTY(t *testing.T) {
+	ForceColors(false)
+	var buf bytes.Buffer
+	group := NewTaskGroup(WithSpinnerWriter(&buf))
+	group.Start()
+
+	group.Go("Build", func(task *Task) error {
+		return nil
+	})
+	deploy := group.Add("Deploy")
+	deploy.Add("eu-west").Done()
+	deploy.Add("us-east").Fail(errors.New("timeout"))
+	deploy.Done()
+
+	group.Wait()
+
+	output := buf.String()
+	for _, expected := range []string{"✓ Build", "✓ eu-west", "✗ us-east: timeout", "✓ Deploy"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Output should contain %q, got %q", expected, output)
+		}
+	}
+	if !strings.Contains(output, "1 of 4 tasks failed") {
+		t.Errorf("Expected failure summary, got %q", output)
+	}
+	if strings.Contains(output, "\x1b[") {
+		t.Error("Non-terminal output should not contain escape sequences")
+	}
+}
+
+func TestTaskGroupLines(t *testing.T) {
+	ForceColors(false)
+	group := NewTaskGroup(WithCustomFrames([]string{"*"}), WithSpinnerWriter(&bytes.Buffer{}))
+
+	parent := group.Add("Parent")
+	parent.Add("Child")
+	parent.Done()
+
+	group.mu.Lock()
+	lines := group.lines()
+	group.mu.Unlock()
+
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 lines, got %d", len(lines))
+	}
+	if !strings.HasPrefix(lines[0], "✓ Parent (") {
+		t.Errorf("Finished task should show a checkmark, got %q", lines[0])
+	}
+	if !strings.HasPrefix(lines[1], "  * Child (") {
+		t.Errorf("Running subtask should be indented with a spinner frame, got %q", lines[1])
+	}
+}
+
+func TestTaskGroupSummary(t *testing.T) {
+	ForceColors(false)
+	var buf bytes.Buffer
+	group := NewTaskGroup(WithSpinnerWriter(&buf))
+	group.Start()
+
+	group.Add("One").Done()
+	group.Add("Two").Done()
+	group.Wait()
+
+	if !strings.Contains(buf.String(), "✓ 2 tasks completed in") {
+		t.Errorf("Expected success summary, got %q", buf.String())
+	}
+}
+
+func TestTaskGroupAnimated(t *testing.T) {
+	ForceColors(true)
+	defer ForceColors(false)
+
+	var buf bytes.Buffer
+	group := NewTaskGroup(WithSpinnerWriter(&buf), WithSpinnerSpeed(5*time.Millisecond))
+	group.Start()
+
+	task := group.Add("Working")
+	time.Sleep(30 * time.Millisecond)
+	task.Done()
+	group.Wait()
+
+	output := buf.String()
+	if !strings.Contains(output, hideCursor) || !strings.Contains(output, showCursor) {
+		t.Error("Animated task group should hide and restore the cursor")
+	}
+	if !strings.Contains(output, clearLine) {
+		t.Error("Animated task group should redraw lines in place")
+	}
+}
+
+func TestTaskGroupNonTTYPrintsEveryTask(t *testing.T) {
+	ForceColors(false)
+	var buf bytes.Buffer
+	group := NewTaskGroup(WithCustomFrames([]string{"*"}), WithSpinnerWriter(&buf))
+
+	group.Add("Early").Done()
+	group.Start()
+
+	deploy := group.Add("Deploy")
+	deploy.Add("eu-west").Done()
+	deploy.Add("us-east")
+	group.Stop()
+
+	output := buf.String()
+	for _, expected := range []string{"  ✓ eu-west (", "✓ Early (", "* Deploy (", "  * us-east ("} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Output should contain %q, got %q", expected, output)
+		}
+	}
+	if count := strings.Count(output, "eu-west"); count != 1 {
+		t.Errorf("Expected eu-west to be printed once, got %d times in %q", count, output)
+	}
+}
+
+func TestTaskGroupNonTTYPrintsParentFirst(t *testing.T) {
+	ForceColors(false)
+	var buf bytes.Buffer
+	group := NewTaskGroup(WithCustomFrames([]string{"*"}), WithSpinnerWriter(&buf))
+	group.Start()
+
+	deploy := group.Add("Deploy")
+	deploy.Add("eu-west").Done()
+	deploy.Done()
+	group.Wait()
+
+	lines := strings.Split(buf.String(), "\n")
+	expected := []string{"* Deploy (", "  ✓ eu-west (", "✓ Deploy ("}
+	if len(lines) < len(expected) {
+		t.Fatalf("Expected at least %d lines, got %q", len(expected), buf.String())
+	}
+	for i, prefix := range expected {
+		if !strings.HasPrefix(lines[i], prefix) {
+			t.Errorf("Line %d: expected prefix %q, got %q", i+1, prefix, lines[i])
+		}
+	}
+}
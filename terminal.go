@@ -0,0 +1,92 @@
+package colorbear
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// ANSI terminal control sequences used for in-place rendering.
+//
+// These are only written when colors (and therefore a capable terminal)
+// are enabled; plain output never contains cursor movement.
+const (
+	cursorUpFmt = "\033[%dA"  // Move the cursor up N lines
+	clearLine   = "\033[2K"   // Erase the entire current line
+	clearDown   = "\033[J"    // Erase from the cursor to the end of the screen
+	hideCursor  = "\033[?25l" // Hide the cursor
+	showCursor  = "\033[?25h" // Show the cursor
+)
+
+// liveArea manages a block of lines at the bottom of the terminal that is
+// redrawn in place.
+//
+// Each update moves the cursor back to the first line of the block, prints
+// any permanent lines (which scroll up and stay on screen), then prints the
+// live lines again. This lets several independently updating items (multiple
+// progress bars, task lists) share the terminal without clobbering each other.
+type liveArea struct {
+	writer io.Writer // Output writer
+	lines  int       // Number of live lines currently on screen
+}
+
+// update redraws the live block.
+//
+// Lines in above are printed once before the live block and are no longer
+// managed afterwards. Lines in live replace the previously drawn block.
+func (a *liveArea) update(above, live []string) {
+	var out strings.Builder
+
+	if a.lines > 0 {
+		out.WriteString(fmt.Sprintf(cursorUpFmt, a.lines))
+	}
+	for _, line := range above {
+		out.WriteString("\r" + clearLine + line + "\n")
+	}
+	for _, line := range live {
+		out.WriteString("\r" + clearLine + line + "\n")
+	}
+	out.WriteString(clearDown)
+
+	fmt.Fprint(a.writer, out.String())
+	a.lines = len(live)
+}
+
+// terminalWidth returns the width of the terminal w writes to, in columns.
+//
+// The size is queried from the terminal itself (see terminalSize). If w is
+// not a terminal or the size is unknown, the COLUMNS environment variable
+// is used. It returns 0 if the width cannot be determined.
+func terminalWidth(w io.Writer) int {
+	if file, ok := w.(*os.File); ok && isTerminalWriter(file) {
+		if width, _, ok := terminalSize(file.Fd()); ok {
+			return width
+		}
+	}
+	return envSize("COLUMNS")
+}
+
+// terminalHeight returns the height of the terminal w writes to, in rows.
+//
+// It works like terminalWidth, with the LINES environment variable as
+// fallback.
+func terminalHeight(w io.Writer) int {
+	if file, ok := w.(*os.File); ok && isTerminalWriter(file) {
+		if _, height, ok := terminalSize(file.Fd()); ok {
+			return height
+		}
+	}
+	return envSize("LINES")
+}
+
+// envSize reads a positive size from an environment variable such as
+// COLUMNS or LINES. It returns 0 if the variable is unset or invalid.
+func envSize(name string) int {
+	size, err := strconv.Atoi(os.Getenv(name))
+	if err != nil || size <= 0 {
+		return 0
+	}
+	return size
+}
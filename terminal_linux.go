@@ -0,0 +1,9 @@
+package colorbear
+
+import "syscall"
+
+// ioctl requests to read and write the terminal settings (see makeRaw).
+const (
+	ioctlGetTermios = syscall.TCGETS
+	ioctlSetTermios = syscall.TCSETS
+)
@@ -0,0 +1,20 @@
+//go:build !linux && !darwin && !windows
+
+package colorbear
+
+import "errors"
+
+// rawReadTimeout reports whether reads in raw mode time out (see makeRaw).
+const rawReadTimeout = false
+
+// terminalSize is not supported on this platform; callers fall back to
+// the COLUMNS and LINES environment variables.
+func terminalSize(fd uintptr) (width, height int, ok bool) {
+	return 0, 0, false
+}
+
+// makeRaw is not supported on this platform; the pager falls back to
+// plain output.
+func makeRaw(fd uintptr) (restore func(), err error) {
+	return nil, errors.New("colorbear: raw terminal mode is not supported on this platform")
+}
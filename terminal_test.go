@@ -0,0 +1,47 @@
+package colorbear
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestLiveAreaUpdate(t *testing.T) {
+	var buf bytes.Buffer
+	area := &liveArea{writer: &buf}
+
+	area.update(nil, []string{"one", "two"})
+	if strings.Contains(buf.String(), "\033[2A") {
+		t.Error("First update should not move the cursor up")
+	}
+	if area.lines != 2 {
+		t.Errorf("Expected 2 live lines, got %d", area.lines)
+	}
+
+	buf.Reset()
+	area.update([]string{"pinned"}, []string{"two"})
+	output := buf.String()
+	if !strings.HasPrefix(output, "\033[2A") {
+		t.Errorf("Second update should move up 2 lines, got %q", output)
+	}
+	if strings.Index(output, "pinned") > strings.Index(output, "two") {
+		t.Error("Pinned lines should be printed above the live block")
+	}
+	if area.lines != 1 {
+		t.Errorf("Expected 1 live line, got %d", area.lines)
+	}
+}
+
+func TestTerminalWidth(t *testing.T) {
+	var buf bytes.Buffer
+
+	t.Setenv("COLUMNS", "120")
+	if width := terminalWidth(&buf); width != 120 {
+		t.Errorf("Expected width 120 from COLUMNS, got %d", width)
+	}
+
+	t.Setenv("COLUMNS", "abc")
+	if width := terminalWidth(&buf); width != 0 {
+		t.Errorf("Expected width 0 for invalid COLUMNS, got %d", width)
+	}
+}
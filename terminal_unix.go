@@ -0,0 +1,55 @@
+//go:build linux || darwin
+
+package colorbear
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+// winsize is the terminal size structure filled by TIOCGWINSZ.
+type winsize struct {
+	Rows, Cols, XPixel, YPixel uint16
+}
+
+// terminalSize returns the size of the terminal behind fd in columns
+// and rows.
+func terminalSize(fd uintptr) (width, height int, ok bool) {
+	var ws winsize
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, fd, uintptr(syscall.TIOCGWINSZ), uintptr(unsafe.Pointer(&ws)))
+	if errno != 0 || ws.Cols == 0 {
+		return 0, 0, false
+	}
+	return int(ws.Cols), int(ws.Rows), true
+}
+
+// rawReadTimeout reports whether reads in raw mode time out (see makeRaw).
+const rawReadTimeout = true
+
+// makeRaw puts the terminal behind fd into raw mode: input is available
+// byte by byte, without echo and without signals for Ctrl+C. Output
+// processing stays on, so "\n" still starts a new line. Reads return
+// empty after 0.1s without input, so that a lone Esc can be told apart
+// from an escape sequence split across reads. The returned function
+// restores the previous mode.
+func makeRaw(fd uintptr) (restore func(), err error) {
+	var old syscall.Termios
+	if _, _, errno := syscall.Syscall(syscall.SYS_IOCTL, fd, ioctlGetTermios, uintptr(unsafe.Pointer(&old))); errno != 0 {
+		return nil, errno
+	}
+
+	raw := old
+	raw.Iflag &^= syscall.IGNBRK | syscall.BRKINT | syscall.PARMRK | syscall.ISTRIP | syscall.INLCR | syscall.IGNCR | syscall.ICRNL | syscall.IXON
+	raw.Lflag &^= syscall.ECHO | syscall.ECHONL | syscall.ICANON | syscall.ISIG | syscall.IEXTEN
+	raw.Cflag &^= syscall.CSIZE | syscall.PARENB
+	raw.Cflag |= syscall.CS8
+	raw.Cc[syscall.VMIN] = 0
+	raw.Cc[syscall.VTIME] = 1 // In tenths of a second
+
+	if _, _, errno := syscall.Syscall(syscall.SYS_IOCTL, fd, ioctlSetTermios, uintptr(unsafe.Pointer(&raw))); errno != 0 {
+		return nil, errno
+	}
+	return func() {
+		syscall.Syscall(syscall.SYS_IOCTL, fd, ioctlSetTermios, uintptr(unsafe.Pointer(&old)))
+	}, nil
+}
@@ -0,0 +1,77 @@
+//go:build windows
+
+package colorbear
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+var (
+	kernel32                       = syscall.NewLazyDLL("kernel32.dll")
+	procGetConsoleScreenBufferInfo = kernel32.NewProc("GetConsoleScreenBufferInfo")
+	procGetConsoleMode             = kernel32.NewProc("GetConsoleMode")
+	procSetConsoleMode             = kernel32.NewProc("SetConsoleMode")
+)
+
+// rawReadTimeout reports whether reads in raw mode time out. The console
+// delivers an escape sequence in a single read, so they do not need to.
+const rawReadTimeout = false
+
+// Console input modes changed by makeRaw.
+const (
+	enableProcessedInput       = 0x0001
+	enableLineInput            = 0x0002
+	enableEchoInput            = 0x0004
+	enableVirtualTerminalInput = 0x0200
+)
+
+// coord and smallRect mirror the Windows console API structures.
+type coord struct {
+	X, Y int16
+}
+
+type smallRect struct {
+	Left, Top, Right, Bottom int16
+}
+
+type consoleScreenBufferInfo struct {
+	Size              coord
+	CursorPosition    coord
+	Attributes        uint16
+	Window            smallRect
+	MaximumWindowSize coord
+}
+
+// terminalSize returns the size of the console window behind fd in
+// columns and rows.
+func terminalSize(fd uintptr) (width, height int, ok bool) {
+	var info consoleScreenBufferInfo
+	r, _, _ := procGetConsoleScreenBufferInfo.Call(fd, uintptr(unsafe.Pointer(&info)))
+	if r == 0 {
+		return 0, 0, false
+	}
+	width = int(info.Window.Right-info.Window.Left) + 1
+	height = int(info.Window.Bottom-info.Window.Top) + 1
+	return width, height, width > 0
+}
+
+// makeRaw puts the console input behind fd into raw mode: input is
+// available key by key, without echo and without Ctrl+C handling, and
+// special keys arrive as VT escape sequences. The returned function
+// restores the previous mode.
+func makeRaw(fd uintptr) (restore func(), err error) {
+	var old uint32
+	if r, _, err := procGetConsoleMode.Call(fd, uintptr(unsafe.Pointer(&old))); r == 0 {
+		return nil, err
+	}
+
+	raw := old &^ (enableProcessedInput | enableLineInput | enableEchoInput)
+	raw |= enableVirtualTerminalInput
+	if r, _, err := procSetConsoleMode.Call(fd, uintptr(raw)); r == 0 {
+		return nil, err
+	}
+	return func() {
+		procSetConsoleMode.Call(fd, uintptr(old))
+	}, nil
+}